@@ -1,30 +1,121 @@
 package array
 
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+// Iterator walks an Array from the start. It fails fast: once the array is
+// modified other than through Remove, including by Sort or Reverse, HasNext
+// returns false and Err reports collection.ErrConcurrentModification.
 type Iterator[T comparable] struct {
-	array *Array[T]
-	index int
+	array    *Array[T]
+	index    int
+	last     int
+	modCount int
+	err      error
 }
 
 func (s *Array[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{array: s, index: 0}
+	return &Iterator[T]{array: s, index: 0, last: -1, modCount: s.modCount}
+}
+
+func (s *Array[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.Size(); i++ {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Array[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.Size() - 1; i >= 0; i-- {
+			if !yield(s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Array[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < s.Size(); i++ {
+			if !yield(i, s.items[i]) {
+				return
+			}
+		}
+	}
+}
+
+func Collect[T comparable](seq iter.Seq[T]) *Array[T] {
+	result := NewArrayList[T]()
+	for value := range seq {
+		result.Add(value)
+	}
+	return result
 }
 
 func (it *Iterator[T]) HasNext() bool {
-	return it.index < it.array.Size()
+	return it.check() == nil && it.index < it.array.Size()
 }
 
+// Next returns the next value, or the zero value if there is none or the
+// array was modified.
 func (it *Iterator[T]) Next() T {
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
 	value := it.array.items[it.index]
+	it.last = it.index
 	it.index++
 	return value
 }
 
+// Remove removes the value last returned by Next. It is the only way to
+// remove values while iterating without failing the iterator.
+func (it *Iterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return collection.ErrIllegalState
+	}
+
+	it.array.RemoveAt(it.last)
+	it.index = it.last
+	it.last = -1
+	it.modCount = it.array.modCount
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the array.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) check() error {
+	if it.err == nil && it.modCount != it.array.modCount {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
+}
+
 func (it *Iterator[T]) Each(action func(T)) {
 	for it.HasNext() {
 		action(it.Next())
 	}
 }
 
+// Map builds an Array of the mapped values.
+//
+// Deprecated: use stream.Map, which maps lazily instead of building an Array
+// of every result first.
 func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *Array[V] {
 	result := NewArrayList[V]()
 	for it.HasNext() {
@@ -33,6 +124,9 @@ func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *Array[V
 	return result
 }
 
+// Reduce folds the values into a single result.
+//
+// Deprecated: use stream.Reduce.
 func Reduce[T comparable, V any](it *Iterator[T], initial V, reducer func(acc V, item T) V) V {
 	acc := initial
 	for it.HasNext() {
@@ -42,6 +136,10 @@ func Reduce[T comparable, V any](it *Iterator[T], initial V, reducer func(acc V,
 	return acc
 }
 
+// Filter builds an Array of the values that match the predicate.
+//
+// Deprecated: use Stream.Filter, which skips values lazily instead of copying
+// the matches into a new Array.
 func Filter[T comparable](it *Iterator[T], predicate func(T) bool) *Array[T] {
 	filtered := NewArrayList[T]()
 	for it.HasNext() {
@@ -1,13 +1,15 @@
 package array
 
 import (
-	"errors"
-	"fmt"
+	"go-utils/collection"
 	"sort"
 )
 
+var _ collection.List[int] = (*Array[int])(nil)
+
 type Array[T comparable] struct {
-	items []T
+	items    []T
+	modCount int
 }
 
 func NewArrayList[T comparable]() *Array[T] {
@@ -27,22 +29,26 @@ func (s *Array[T]) Values() []T {
 }
 
 func (s *Array[T]) Clear() {
+	s.modCount++
 	s.items = []T{}
 }
 
 func (s *Array[T]) Add(value T) {
+	s.modCount++
 	s.items = append(s.items, value)
 }
 
 func (s *Array[T]) AddAll(values []T) {
+	s.modCount++
 	s.items = append(s.items, values...)
 }
 
 func (s *Array[T]) InsertAt(index int, value T) error {
 	if index < 0 || index > s.Size() {
-		return errors.New(fmt.Sprintf("Index %d is out of range with size %d", index, s.Size()))
+		return &collection.IndexError{Index: index, Size: s.Size()}
 	}
 
+	s.modCount++
 	if index == 0 {
 		// add to the start
 		s.items = append([]T{value}, s.items...)
@@ -63,12 +69,17 @@ func (s *Array[T]) InsertAt(index int, value T) error {
 func (s *Array[T]) Get(index int) (T, error) {
 	if index < 0 || index >= s.Size() {
 		var zero T
-		return zero, errors.New(fmt.Sprintf("Index %d is out of range with size %d", index, s.Size()))
+		return zero, &collection.IndexError{Index: index, Size: s.Size()}
 	}
 
 	return s.items[index], nil
 }
 
+// MustGet is like Get but panics on error.
+func (s *Array[T]) MustGet(index int) T {
+	return collection.Must(s.Get(index))
+}
+
 func (s *Array[T]) SetAt(index int, value T) bool {
 	if index < 0 || index >= s.Size() {
 		return false
@@ -81,9 +92,10 @@ func (s *Array[T]) SetAt(index int, value T) bool {
 func (s *Array[T]) RemoveAt(index int) (T, error) {
 	if index < 0 || index >= s.Size() {
 		var zero T
-		return zero, errors.New(fmt.Sprintf("Index %d is out of range with size %d", index, s.Size()))
+		return zero, &collection.IndexError{Index: index, Size: s.Size()}
 	}
 
+	s.modCount++
 	value := s.items[index]
 	if index == 0 {
 		// remove the start
@@ -98,6 +110,13 @@ func (s *Array[T]) RemoveAt(index int) (T, error) {
 	return value, nil
 }
 
+// ModCount returns how many times the array has changed size or been sorted
+// or reversed. Iterators compare it with the count they started from to
+// detect modifications.
+func (s *Array[T]) ModCount() int {
+	return s.modCount
+}
+
 func (s *Array[T]) Clone() *Array[T] {
 	itemsCopy := make([]T, s.Size())
 	copy(itemsCopy, s.items)
@@ -105,10 +124,12 @@ func (s *Array[T]) Clone() *Array[T] {
 }
 
 func (s *Array[T]) Merge(list *Array[T]) {
+	s.modCount++
 	s.items = append(s.items, list.items...)
 }
 
 func (s *Array[T]) Reverse() {
+	s.modCount++
 	for i, j := 0, s.Size()-1; i < j; i, j = i+1, j-1 {
 		s.items[i], s.items[j] = s.items[j], s.items[i]
 	}
@@ -123,6 +144,7 @@ func (s *Array[T]) Swap(left, right int) {
 }
 
 func (s *Array[T]) Sort(comparator func(T, T) int) {
+	s.modCount++
 	sort.Slice(s.items, func(i, j int) bool {
 		return comparator(s.items[i], s.items[j]) <= 0
 	})
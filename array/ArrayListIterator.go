@@ -0,0 +1,167 @@
+package array
+
+import (
+	"go-utils/collection"
+)
+
+var (
+	_ collection.Iterator[int] = (*ListIterator[int])(nil)
+	_ collection.Iterator[int] = (*ReverseIterator[int])(nil)
+)
+
+// ListIterator walks an Array in both directions, like Java's ListIterator.
+// Its cursor sits between two values: Next returns the value after it and
+// Previous the value before it. Set, Remove and Add work on the value last
+// returned by Next or Previous, and only Remove and Add keep the iterator
+// valid when they change the size of the array.
+type ListIterator[T comparable] struct {
+	array    *Array[T]
+	cursor   int
+	last     int
+	modCount int
+	err      error
+}
+
+// ListIterator returns an iterator positioned before the first value.
+func (s *Array[T]) ListIterator() *ListIterator[T] {
+	return &ListIterator[T]{array: s, last: -1, modCount: s.modCount}
+}
+
+// ListIteratorAt returns an iterator whose first call to Next returns the
+// value at index. An index equal to the size positions it after the last value.
+func (s *Array[T]) ListIteratorAt(index int) (*ListIterator[T], error) {
+	if index < 0 || index > s.Size() {
+		return nil, &collection.IndexError{Index: index, Size: s.Size()}
+	}
+	return &ListIterator[T]{array: s, cursor: index, last: -1, modCount: s.modCount}, nil
+}
+
+func (it *ListIterator[T]) HasNext() bool {
+	return it.check() == nil && it.cursor < it.array.Size()
+}
+
+// Next returns the value after the cursor and moves the cursor past it, or
+// returns the zero value if there is none or the array was modified.
+func (it *ListIterator[T]) Next() T {
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
+	it.last = it.cursor
+	it.cursor++
+	return it.array.items[it.last]
+}
+
+func (it *ListIterator[T]) HasPrevious() bool {
+	return it.check() == nil && it.cursor > 0
+}
+
+// Previous returns the value before the cursor and moves the cursor back
+// over it, or returns the zero value if there is none or the array was
+// modified.
+func (it *ListIterator[T]) Previous() T {
+	if !it.HasPrevious() {
+		var zero T
+		return zero
+	}
+
+	it.cursor--
+	it.last = it.cursor
+	return it.array.items[it.last]
+}
+
+// NextIndex returns the index of the value Next would return, or the size of
+// the array at the end.
+func (it *ListIterator[T]) NextIndex() int {
+	return it.cursor
+}
+
+// PreviousIndex returns the index of the value Previous would return, or -1
+// at the start.
+func (it *ListIterator[T]) PreviousIndex() int {
+	return it.cursor - 1
+}
+
+// Set replaces the value last returned by Next or Previous.
+func (it *ListIterator[T]) Set(value T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return collection.ErrIllegalState
+	}
+
+	it.array.items[it.last] = value
+	return nil
+}
+
+// Remove removes the value last returned by Next or Previous.
+func (it *ListIterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return collection.ErrIllegalState
+	}
+
+	it.array.RemoveAt(it.last)
+	it.cursor = it.last
+	it.last = -1
+	it.modCount = it.array.modCount
+	return nil
+}
+
+// Add inserts the value at the cursor, so that Previous would return it and
+// Next is unaffected.
+func (it *ListIterator[T]) Add(value T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+
+	it.array.InsertAt(it.cursor, value)
+	it.cursor++
+	it.last = -1
+	it.modCount = it.array.modCount
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the array.
+func (it *ListIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *ListIterator[T]) check() error {
+	if it.err == nil && it.modCount != it.array.modCount {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
+}
+
+// ReverseIterator walks an Array from the last value to the first without
+// modifying it. It fails fast like Iterator.
+type ReverseIterator[T comparable] struct {
+	it *ListIterator[T]
+}
+
+func (s *Array[T]) ReverseIterator() *ReverseIterator[T] {
+	return &ReverseIterator[T]{&ListIterator[T]{array: s, cursor: s.Size(), last: -1, modCount: s.modCount}}
+}
+
+func (it *ReverseIterator[T]) HasNext() bool {
+	return it.it.HasPrevious()
+}
+
+func (it *ReverseIterator[T]) Next() T {
+	return it.it.Previous()
+}
+
+// Remove removes the value last returned by Next.
+func (it *ReverseIterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+func (it *ReverseIterator[T]) Err() error {
+	return it.it.Err()
+}
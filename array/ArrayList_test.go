@@ -1,6 +1,8 @@
 package array
 
 import (
+	"go-utils/collection"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -186,3 +188,151 @@ func TestArrayList_Filter(t *testing.T) {
 	even := Filter(arr.Iterator(), func(value int) bool { return value%2 == 0 })
 	require.ElementsMatch(t, []int{2, 4, 6}, even.Values(), "ArrayList filter is failed")
 }
+
+func TestArrayList_All(t *testing.T) {
+	arr := NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3, 4})
+
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(arr.All()), "ArrayList all is failed")
+	require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(arr.Backward()), "ArrayList backward is failed")
+
+	indexes := []int{}
+	for i, value := range arr.Enumerate() {
+		require.Equal(t, arr.items[i], value, "ArrayList enumerate item is not matched")
+		indexes = append(indexes, i)
+	}
+	require.Equal(t, []int{0, 1, 2, 3}, indexes, "ArrayList enumerate is failed")
+
+	// stop early
+	visited := []int{}
+	for value := range arr.All() {
+		if value == 3 {
+			break
+		}
+		visited = append(visited, value)
+	}
+	require.Equal(t, []int{1, 2}, visited, "ArrayList all break is failed")
+
+	collected := Collect(arr.Backward())
+	require.Equal(t, []int{4, 3, 2, 1}, collected.Values(), "ArrayList collect is failed")
+}
+
+func TestArrayList_IteratorFailFast(t *testing.T) {
+	arr := NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3})
+
+	it := arr.Iterator()
+	require.Equal(t, 1, it.Next(), "ArrayList iterator next is not equal")
+	arr.RemoveAt(2)
+	require.False(t, it.HasNext(), "ArrayList iterator has next after modification is not matched")
+	require.Equal(t, 0, it.Next(), "ArrayList iterator next after modification is not equal")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayList iterator error is not matched")
+	require.ErrorIs(t, it.Remove(), collection.ErrConcurrentModification, "ArrayList iterator remove is not failed")
+
+	it = arr.Iterator()
+	arr.SetAt(0, 10)
+	require.True(t, it.HasNext(), "ArrayList iterator after set is not matched")
+	require.Nil(t, it.Err(), "ArrayList iterator error after set is not nil")
+
+	for _, reorder := range []func(){arr.Reverse, func() { arr.Sort(func(a, b int) int { return a - b }) }} {
+		it = arr.Iterator()
+		reorder()
+		require.False(t, it.HasNext(), "ArrayList iterator has next after reorder is not matched")
+		require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayList iterator error after reorder is not matched")
+	}
+}
+
+func TestArrayList_IteratorRemove(t *testing.T) {
+	arr := NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3, 4, 5, 6})
+
+	it := arr.Iterator()
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "ArrayList iterator remove before next is not failed")
+	visited := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		visited = append(visited, value)
+		if value%2 == 0 {
+			require.Nil(t, it.Remove(), "ArrayList iterator remove is failed")
+			require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "ArrayList iterator remove twice is not failed")
+		}
+	}
+	require.Nil(t, it.Err(), "ArrayList iterator error is not nil")
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, visited, "ArrayList iterator values are not matched")
+	require.Equal(t, []int{1, 3, 5}, arr.Values(), "ArrayList values are not equal")
+}
+
+func TestArrayList_ListIterator(t *testing.T) {
+	arr := NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3})
+
+	it := arr.ListIterator()
+	require.False(t, it.HasPrevious(), "ArrayList list iterator has previous at start is not matched")
+	require.Equal(t, -1, it.PreviousIndex(), "ArrayList list iterator previous index is not equal")
+	require.ErrorIs(t, it.Set(0), collection.ErrIllegalState, "ArrayList list iterator set before next is not failed")
+
+	require.Equal(t, 1, it.Next(), "ArrayList list iterator next is not equal")
+	require.Equal(t, 2, it.Next(), "ArrayList list iterator next is not equal")
+	require.Equal(t, 2, it.NextIndex(), "ArrayList list iterator next index is not equal")
+	require.Equal(t, 2, it.Previous(), "ArrayList list iterator previous is not equal")
+	require.Equal(t, 1, it.NextIndex(), "ArrayList list iterator next index is not equal")
+
+	require.Nil(t, it.Set(20), "ArrayList list iterator set is failed")
+	require.Nil(t, it.Remove(), "ArrayList list iterator remove after previous is failed")
+	require.ErrorIs(t, it.Set(0), collection.ErrIllegalState, "ArrayList list iterator set after remove is not failed")
+	require.Equal(t, []int{1, 3}, arr.Values(), "ArrayList values are not equal")
+	require.Equal(t, 1, it.NextIndex(), "ArrayList list iterator next index is not equal")
+
+	require.Nil(t, it.Add(4), "ArrayList list iterator add is failed")
+	require.Nil(t, it.Add(5), "ArrayList list iterator add is failed")
+	require.Equal(t, []int{1, 4, 5, 3}, arr.Values(), "ArrayList values are not equal")
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "ArrayList list iterator remove after add is not failed")
+	require.Equal(t, 3, it.Next(), "ArrayList list iterator next after add is not equal")
+	require.Nil(t, it.Remove(), "ArrayList list iterator remove after next is failed")
+	require.Equal(t, 3, it.NextIndex(), "ArrayList list iterator next index is not equal")
+	require.False(t, it.HasNext(), "ArrayList list iterator has next at end is not matched")
+
+	values := []int{}
+	for it.HasPrevious() {
+		values = append(values, it.Previous())
+	}
+	require.Equal(t, []int{5, 4, 1}, values, "ArrayList list iterator backward is not matched")
+
+	arr.Add(6)
+	require.False(t, it.HasNext(), "ArrayList list iterator has next after modification is not matched")
+	require.ErrorIs(t, it.Add(7), collection.ErrConcurrentModification, "ArrayList list iterator add after modification is not failed")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayList list iterator error is not matched")
+
+	it, err := arr.ListIteratorAt(2)
+	require.Nil(t, err, "ArrayList list iterator at is failed")
+	require.Equal(t, 5, it.Next(), "ArrayList list iterator at next is not equal")
+	it, err = arr.ListIteratorAt(arr.Size())
+	require.Nil(t, err, "ArrayList list iterator at size is failed")
+	require.Equal(t, 6, it.Previous(), "ArrayList list iterator at size previous is not equal")
+	_, err = arr.ListIteratorAt(arr.Size() + 1)
+	require.NotNil(t, err, "ArrayList list iterator out of range is not failed")
+}
+
+func TestArrayList_ReverseIterator(t *testing.T) {
+	arr := NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3, 4})
+
+	it := arr.ReverseIterator()
+	values := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		values = append(values, value)
+		if value%2 == 0 {
+			require.Nil(t, it.Remove(), "ArrayList reverse iterator remove is failed")
+		}
+	}
+	require.Nil(t, it.Err(), "ArrayList reverse iterator error is not nil")
+	require.Equal(t, []int{4, 3, 2, 1}, values, "ArrayList reverse iterator values are not matched")
+	require.Equal(t, []int{1, 3}, arr.Values(), "ArrayList values are not equal")
+
+	it = arr.ReverseIterator()
+	it.Next()
+	arr.RemoveAt(0)
+	require.False(t, it.HasNext(), "ArrayList reverse iterator has next after modification is not matched")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayList reverse iterator error is not matched")
+}
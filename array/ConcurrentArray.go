@@ -1,6 +1,11 @@
 package array
 
-import "sync"
+import (
+	"go-utils/collection"
+	"sync"
+)
+
+var _ collection.List[int] = (*ConcurrentArray[int])(nil)
 
 type ConcurrentArray[T comparable] struct {
 	mu  sync.RWMutex
@@ -12,10 +17,16 @@ func NewConcurrentArray[T comparable]() *ConcurrentArray[T] {
 }
 
 func (s *ConcurrentArray[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return s.arr.Size()
 }
 
 func (s *ConcurrentArray[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return s.arr.IsEmpty()
 }
 
@@ -26,11 +37,17 @@ func (s *ConcurrentArray[T]) Clear() {
 	s.arr.Clear()
 }
 
+// Values returns a copy, so the result can be used after the lock is released.
 func (s *ConcurrentArray[T]) Values() []T {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	return s.arr.Values()
+	return s.arr.Clone().items
+}
+
+// Snapshot returns a copy of the values taken under the read lock.
+func (s *ConcurrentArray[T]) Snapshot() []T {
+	return s.Values()
 }
 
 func (s *ConcurrentArray[T]) Add(value T) {
@@ -98,8 +115,8 @@ func (s *ConcurrentArray[T]) Reverse() {
 }
 
 func (s *ConcurrentArray[T]) Compare(left, right int, comparator func(a, b T) int) int {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
 	return s.arr.Compare(left, right, comparator)
 }
@@ -46,18 +46,27 @@ func TestConcurrentArrayList_Order(t *testing.T) {
 	}
 
 	// consume 100 items
+	var consumedMutex sync.Mutex
 	consumed := make([]int, 0, 100)
 	for c := 0; c < 10; c++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 
-			for len(consumed) < 100 {
+			for {
 				time.Sleep(time.Duration(100+rand.Intn(100)) * time.Millisecond)
+
+				consumedMutex.Lock()
+				if len(consumed) >= 100 {
+					consumedMutex.Unlock()
+					return
+				}
 				if !arr.IsEmpty() {
-					value, _ := arr.RemoveAt(0)
-					consumed = append(consumed, value)
+					if value, err := arr.RemoveAt(0); err == nil {
+						consumed = append(consumed, value)
+					}
 				}
+				consumedMutex.Unlock()
 			}
 		}()
 	}
@@ -220,3 +229,42 @@ func validateConcurrentArray(t *testing.T, arr *ConcurrentArray[int], index, exp
 	require.Nil(t, err, "ConcurrentArray get is failed")
 	require.Equal(t, expectedValue, value, "ConcurrentArray get item is not matched")
 }
+
+func TestConcurrentArray_Stress(t *testing.T) {
+	arr := NewConcurrentArray[int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				arr.Add(w*500 + i)
+				_ = arr.Size()
+				_ = arr.IsEmpty()
+				_ = arr.Contains(i)
+				_, _ = arr.Get(0)
+				values := arr.Values()
+				if len(values) > 0 {
+					values[0] = -1
+				}
+				if i%5 == 0 {
+					_, _ = arr.RemoveAt(0)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 16*400, arr.Size(), "ConcurrentArray size is not equal")
+	require.False(t, arr.Contains(-1), "ConcurrentArray values are not a copy")
+}
+
+func TestConcurrentArray_ValuesCopy(t *testing.T) {
+	arr := NewConcurrentArray[int]()
+	arr.AddAll([]int{1, 2, 3})
+
+	values := arr.Values()
+	values[0] = 10
+	require.Equal(t, []int{1, 2, 3}, arr.Values(), "ConcurrentArray values are not a copy")
+}
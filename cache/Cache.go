@@ -0,0 +1,122 @@
+package cache
+
+import (
+	"go-utils/list"
+	"iter"
+	"time"
+)
+
+// Cache maps keys to values and drops entries on its own, by capacity or age.
+//
+// Get counts as a use of the entry and updates the statistics; Peek and
+// ContainsKey do neither.
+type Cache[K comparable, V any] interface {
+	Size() int
+	IsEmpty() bool
+	Capacity() int
+	Clear()
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Peek(key K) (V, bool)
+	ContainsKey(key K) bool
+	Remove(key K) (V, bool)
+	Entries() iter.Seq2[K, V]
+	Stats() Stats
+	OnEvict(callback func(key K, value V, reason EvictionReason))
+}
+
+// EvictionReason tells an eviction callback why the entry was dropped.
+type EvictionReason int
+
+const (
+	// Capacity means the entry made room for a new one in a full cache.
+	Capacity EvictionReason = iota
+	// Expired means the entry outlived its time to live.
+	Expired
+)
+
+func (r EvictionReason) String() string {
+	switch r {
+	case Capacity:
+		return "capacity"
+	case Expired:
+		return "expired"
+	default:
+		return "unknown"
+	}
+}
+
+// Stats counts the lookups and evictions of a cache since it was created.
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64
+	Expirations uint64
+}
+
+// HitRate returns the fraction of Get calls that found the key, or 0 before
+// the first one.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	frequency int
+	expires   time.Time
+	element   *list.Element[*entry[K, V]]
+}
+
+// entryList keeps entries in a list.LinkedList and uses the element of each
+// entry as its handle, so that an entry can be moved or removed in O(1).
+type entryList[K comparable, V any] struct {
+	items *list.LinkedList[*entry[K, V]]
+}
+
+func newEntryList[K comparable, V any]() *entryList[K, V] {
+	return &entryList[K, V]{items: list.NewLinkedList[*entry[K, V]]()}
+}
+
+func (s *entryList[K, V]) isEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *entryList[K, V]) attachHeadNode(node *entry[K, V]) {
+	node.element = s.items.PushFrontElement(node)
+}
+
+func (s *entryList[K, V]) detachNode(node *entry[K, V]) {
+	s.items.Remove(node.element)
+	node.element = nil
+}
+
+func (s *entryList[K, V]) moveToHead(node *entry[K, V]) {
+	s.items.MoveToFront(node.element)
+}
+
+// tailNode returns the last entry, or nil if the list is empty.
+func (s *entryList[K, V]) tailNode() *entry[K, V] {
+	if back := s.items.Back(); back != nil {
+		return back.Value()
+	}
+	return nil
+}
+
+// all yields the entries from head to tail. The current entry may be detached
+// while iterating.
+func (s *entryList[K, V]) all() iter.Seq[*entry[K, V]] {
+	return func(yield func(*entry[K, V]) bool) {
+		for element := s.items.Front(); element != nil; {
+			next := element.Next()
+			if !yield(element.Value()) {
+				return
+			}
+			element = next
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"iter"
+	"sync"
+	"time"
+)
+
+var _ Cache[int, int] = (*ConcurrentCache[int, int])(nil)
+
+// expiring is implemented by caches whose entries have a time to live.
+type expiring[K comparable, V any] interface {
+	PutWithTTL(key K, value V, ttl time.Duration)
+	RemoveExpired() int
+}
+
+// ConcurrentCache makes any Cache safe for concurrent use behind a single
+// mutex; even Get needs it, since a lookup reorders the entries. Eviction
+// callbacks run while the lock is held and must not call back into the cache.
+type ConcurrentCache[K comparable, V any] struct {
+	mu    sync.Mutex
+	cache Cache[K, V]
+	stop  chan struct{}
+	once  sync.Once
+}
+
+func NewConcurrentCache[K comparable, V any](cache Cache[K, V]) *ConcurrentCache[K, V] {
+	return &ConcurrentCache[K, V]{cache: cache, stop: make(chan struct{})}
+}
+
+func NewConcurrentLRUCache[K comparable, V any](capacity int) *ConcurrentCache[K, V] {
+	return NewConcurrentCache[K, V](NewLRUCache[K, V](capacity))
+}
+
+func NewConcurrentLFUCache[K comparable, V any](capacity int) *ConcurrentCache[K, V] {
+	return NewConcurrentCache[K, V](NewLFUCache[K, V](capacity))
+}
+
+// NewConcurrentTTLCache wraps a TTLCache and, if interval is positive, removes
+// its expired entries in the background every interval until Close is called.
+func NewConcurrentTTLCache[K comparable, V any](capacity int, ttl, interval time.Duration) *ConcurrentCache[K, V] {
+	s := NewConcurrentCache[K, V](NewTTLCache[K, V](capacity, ttl))
+	if interval > 0 {
+		go s.expire(interval)
+	}
+	return s
+}
+
+func (s *ConcurrentCache[K, V]) Size() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Size()
+}
+
+func (s *ConcurrentCache[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *ConcurrentCache[K, V]) Capacity() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Capacity()
+}
+
+func (s *ConcurrentCache[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Clear()
+}
+
+func (s *ConcurrentCache[K, V]) Put(key K, value V) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Put(key, value)
+}
+
+// PutWithTTL stores the value with its own time to live. On a cache without
+// expiry it is the same as Put.
+func (s *ConcurrentCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cache, ok := s.cache.(expiring[K, V]); ok {
+		cache.PutWithTTL(key, value, ttl)
+	} else {
+		s.cache.Put(key, value)
+	}
+}
+
+func (s *ConcurrentCache[K, V]) Get(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Get(key)
+}
+
+// GetOrLoad returns the cached value or stores and returns the one produced
+// by load. The lock is released while load runs, so concurrent misses on the
+// same key may each call it; the last value stored wins.
+func (s *ConcurrentCache[K, V]) GetOrLoad(key K, load func(K) (V, error)) (V, error) {
+	if value, ok := s.Get(key); ok {
+		return value, nil
+	}
+
+	value, err := load(key)
+	if err != nil {
+		return value, err
+	}
+	s.Put(key, value)
+	return value, nil
+}
+
+func (s *ConcurrentCache[K, V]) Peek(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Peek(key)
+}
+
+func (s *ConcurrentCache[K, V]) ContainsKey(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.ContainsKey(key)
+}
+
+func (s *ConcurrentCache[K, V]) Remove(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Remove(key)
+}
+
+// RemoveExpired removes the expired entries of a cache with expiry and
+// returns how many were removed.
+func (s *ConcurrentCache[K, V]) RemoveExpired() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cache, ok := s.cache.(expiring[K, V]); ok {
+		return cache.RemoveExpired()
+	}
+	return 0
+}
+
+// Entries yields a snapshot of the entries taken under the lock.
+func (s *ConcurrentCache[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s.mu.Lock()
+		keys := make([]K, 0, s.cache.Size())
+		values := make([]V, 0, s.cache.Size())
+		for key, value := range s.cache.Entries() {
+			keys = append(keys, key)
+			values = append(values, value)
+		}
+		s.mu.Unlock()
+
+		for i, key := range keys {
+			if !yield(key, values[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ConcurrentCache[K, V]) Stats() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.cache.Stats()
+}
+
+func (s *ConcurrentCache[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.OnEvict(callback)
+}
+
+// Close stops the background expiry, if any. The cache stays usable.
+func (s *ConcurrentCache[K, V]) Close() {
+	s.once.Do(func() { close(s.stop) })
+}
+
+func (s *ConcurrentCache[K, V]) expire(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.RemoveExpired()
+		case <-s.stop:
+			return
+		}
+	}
+}
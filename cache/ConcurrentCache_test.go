@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentCache(t *testing.T) {
+	cache := NewConcurrentLRUCache[int, int](100)
+	var evictions atomic.Int64
+	cache.OnEvict(func(key, value int, reason EvictionReason) {
+		evictions.Add(1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(offset int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				cache.Put(offset*1000+j, j)
+				cache.Get(offset*1000 + j/2)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, 100, cache.Size(), "ConcurrentCache size is not equal")
+	require.Equal(t, int64(7900), evictions.Load(), "ConcurrentCache evictions are not equal")
+	require.Equal(t, uint64(8000), cache.Stats().Hits+cache.Stats().Misses, "ConcurrentCache lookups are not equal")
+	require.Equal(t, 100, len(collectKeys[int, int](cache)), "ConcurrentCache entries are not matched")
+
+	loads := 0
+	load := func(key int) (int, error) {
+		loads++
+		return key * 2, nil
+	}
+	value, err := cache.GetOrLoad(-1, load)
+	require.Nil(t, err, "ConcurrentCache get or load is failed")
+	require.Equal(t, -2, value, "ConcurrentCache get or load is not equal")
+	cache.GetOrLoad(-1, load)
+	require.Equal(t, 1, loads, "ConcurrentCache loads are not equal")
+	require.Equal(t, 0, cache.RemoveExpired(), "ConcurrentCache remove expired without expiry is not equal")
+}
+
+func TestConcurrentCache_BackgroundExpiry(t *testing.T) {
+	cache := NewConcurrentTTLCache[string, int](0, 10*time.Millisecond, 5*time.Millisecond)
+	defer cache.Close()
+
+	expired := make(chan string, 2)
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		expired <- key
+	})
+	cache.Put("a", 1)
+	cache.PutWithTTL("b", 2, time.Hour)
+
+	select {
+	case key := <-expired:
+		require.Equal(t, "a", key, "ConcurrentCache expired key is not matched")
+	case <-time.After(time.Second):
+		require.Fail(t, "ConcurrentCache background expiry is not matched")
+	}
+	require.Equal(t, 1, cache.Size(), "ConcurrentCache size is not equal")
+	require.True(t, cache.ContainsKey("b"), "ConcurrentCache contains(b) is not matched")
+
+	cache.Close()
+	cache.Close()
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+var _ Cache[int, int] = (*LFUCache[int, int])(nil)
+
+// LFUCache holds up to capacity entries and evicts the least frequently used
+// one to make room, breaking ties by evicting the least recently used. Every
+// operation is O(1): entries are kept in one list per use count, and the
+// cache remembers the lowest count in use.
+type LFUCache[K comparable, V any] struct {
+	items        map[K]*entry[K, V]
+	frequencies  map[int]*entryList[K, V]
+	minFrequency int
+	capacity     int
+	stats        Stats
+	onEvict      func(key K, value V, reason EvictionReason)
+}
+
+// NewLFUCache creates a cache holding up to capacity entries, at least one.
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	return &LFUCache[K, V]{
+		items:       map[K]*entry[K, V]{},
+		frequencies: map[int]*entryList[K, V]{},
+		capacity:    max(capacity, 1),
+	}
+}
+
+func (s *LFUCache[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *LFUCache[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *LFUCache[K, V]) Capacity() int {
+	return s.capacity
+}
+
+func (s *LFUCache[K, V]) Clear() {
+	s.items = map[K]*entry[K, V]{}
+	s.frequencies = map[int]*entryList[K, V]{}
+	s.minFrequency = 0
+}
+
+// Put stores the value and counts as a use of the key. A new key starts with
+// a count of one, after evicting the least frequently used entry if the cache
+// is full.
+func (s *LFUCache[K, V]) Put(key K, value V) {
+	if node, ok := s.items[key]; ok {
+		node.value = value
+		s.touch(node)
+		return
+	}
+
+	if len(s.items) >= s.capacity {
+		s.evict(s.frequencies[s.minFrequency].tailNode(), Capacity)
+	}
+
+	node := &entry[K, V]{key: key, value: value, frequency: 1}
+	s.listOf(1).attachHeadNode(node)
+	s.items[key] = node
+	s.minFrequency = 1
+}
+
+func (s *LFUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		s.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	s.stats.Hits++
+	s.touch(node)
+	return node.value, true
+}
+
+func (s *LFUCache[K, V]) Peek(key K) (V, bool) {
+	if node, ok := s.items[key]; ok {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (s *LFUCache[K, V]) ContainsKey(key K) bool {
+	_, ok := s.items[key]
+	return ok
+}
+
+func (s *LFUCache[K, V]) Remove(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	s.detach(node)
+	return node.value, true
+}
+
+// Frequency returns how many times the key has been used, without counting
+// this call.
+func (s *LFUCache[K, V]) Frequency(key K) int {
+	if node, ok := s.items[key]; ok {
+		return node.frequency
+	}
+	return 0
+}
+
+// Entries yields the entries from most to least frequently used.
+func (s *LFUCache[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		frequencies := slices.Sorted(maps.Keys(s.frequencies))
+		for _, frequency := range slices.Backward(frequencies) {
+			for node := range s.frequencies[frequency].all() {
+				if !yield(node.key, node.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *LFUCache[K, V]) Stats() Stats {
+	return s.stats
+}
+
+// OnEvict registers a callback run for every entry the cache evicts. It is
+// not run for Remove, Clear or a Put that replaces a value.
+func (s *LFUCache[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	s.onEvict = callback
+}
+
+// touch moves the entry to the list of the next use count.
+func (s *LFUCache[K, V]) touch(node *entry[K, V]) {
+	frequency := node.frequency
+	s.unlink(node)
+	if frequency == s.minFrequency && s.frequencies[frequency] == nil {
+		s.minFrequency++
+	}
+
+	node.frequency++
+	s.listOf(node.frequency).attachHeadNode(node)
+}
+
+func (s *LFUCache[K, V]) evict(node *entry[K, V], reason EvictionReason) {
+	s.detach(node)
+	s.stats.Evictions++
+	if s.onEvict != nil {
+		s.onEvict(node.key, node.value, reason)
+	}
+}
+
+// detach removes the entry from the cache. It may leave the lowest count
+// stale, but the cache is no longer full, so the next eviction comes after a
+// Put of a new key, which resets the lowest count to one.
+func (s *LFUCache[K, V]) detach(node *entry[K, V]) {
+	s.unlink(node)
+	delete(s.items, node.key)
+}
+
+// unlink takes the entry out of its list and drops the list once empty.
+func (s *LFUCache[K, V]) unlink(node *entry[K, V]) {
+	list := s.frequencies[node.frequency]
+	list.detachNode(node)
+	if list.isEmpty() {
+		delete(s.frequencies, node.frequency)
+	}
+}
+
+func (s *LFUCache[K, V]) listOf(frequency int) *entryList[K, V] {
+	list, ok := s.frequencies[frequency]
+	if !ok {
+		list = newEntryList[K, V]()
+		s.frequencies[frequency] = list
+	}
+	return list
+}
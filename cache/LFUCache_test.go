@@ -0,0 +1,51 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLFUCache(t *testing.T) {
+	cache := NewLFUCache[string, int](3)
+	evicted := []string{}
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		evicted = append(evicted, key)
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	require.Equal(t, 3, cache.Frequency("a"), "LFUCache frequency(a) is not equal")
+	require.Equal(t, 1, cache.Frequency("c"), "LFUCache frequency(c) is not equal")
+
+	cache.Put("d", 4)
+	require.Equal(t, []string{"c"}, evicted, "LFUCache evicted keys are not matched")
+
+	// d and the next new key tie at one use, so the older one goes
+	cache.Put("e", 5)
+	require.Equal(t, []string{"c", "d"}, evicted, "LFUCache evicted keys are not matched")
+	require.Equal(t, []string{"a", "b", "e"}, collectKeys[string, int](cache), "LFUCache entries are not matched")
+
+	_, ok := cache.Get("c")
+	require.False(t, ok, "LFUCache get(c) is not matched")
+	require.Equal(t, Stats{Hits: 3, Misses: 1, Evictions: 2}, cache.Stats(), "LFUCache stats are not equal")
+
+	value, ok := cache.Remove("e")
+	require.True(t, ok, "LFUCache remove(e) is not matched")
+	require.Equal(t, 5, value, "LFUCache remove(e) is not equal")
+	cache.Get("b")
+	cache.Put("f", 6)
+	cache.Put("g", 7)
+	require.Equal(t, []string{"c", "d", "f"}, evicted, "LFUCache evicted keys after remove are not matched")
+	require.Equal(t, 3, cache.Size(), "LFUCache size is not equal")
+
+	cache.Clear()
+	require.True(t, cache.IsEmpty(), "LFUCache clear is not matched")
+	cache.Put("h", 8)
+	value, _ = cache.Peek("h")
+	require.Equal(t, 8, value, "LFUCache peek(h) is not equal")
+}
@@ -0,0 +1,129 @@
+package cache
+
+import "iter"
+
+var _ Cache[int, int] = (*LRUCache[int, int])(nil)
+
+// LRUCache holds up to capacity entries and evicts the least recently used
+// one to make room. Every operation is O(1): the map finds the entry and the
+// list keeps the entries from most to least recently used.
+type LRUCache[K comparable, V any] struct {
+	items    map[K]*entry[K, V]
+	list     *entryList[K, V]
+	capacity int
+	stats    Stats
+	onEvict  func(key K, value V, reason EvictionReason)
+}
+
+// NewLRUCache creates a cache holding up to capacity entries, at least one.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return &LRUCache[K, V]{
+		items:    map[K]*entry[K, V]{},
+		list:     newEntryList[K, V](),
+		capacity: max(capacity, 1),
+	}
+}
+
+func (s *LRUCache[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *LRUCache[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *LRUCache[K, V]) Capacity() int {
+	return s.capacity
+}
+
+func (s *LRUCache[K, V]) Clear() {
+	s.items = map[K]*entry[K, V]{}
+	s.list = newEntryList[K, V]()
+}
+
+// Put stores the value and marks the key as the most recently used, evicting
+// the least recently used entry if the cache is full.
+func (s *LRUCache[K, V]) Put(key K, value V) {
+	if node, ok := s.items[key]; ok {
+		node.value = value
+		s.list.moveToHead(node)
+		return
+	}
+
+	if len(s.items) >= s.capacity {
+		s.evict(s.list.tailNode(), Capacity)
+	}
+
+	node := &entry[K, V]{key: key, value: value}
+	s.list.attachHeadNode(node)
+	s.items[key] = node
+}
+
+func (s *LRUCache[K, V]) Get(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		s.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	s.stats.Hits++
+	s.list.moveToHead(node)
+	return node.value, true
+}
+
+func (s *LRUCache[K, V]) Peek(key K) (V, bool) {
+	if node, ok := s.items[key]; ok {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (s *LRUCache[K, V]) ContainsKey(key K) bool {
+	_, ok := s.items[key]
+	return ok
+}
+
+func (s *LRUCache[K, V]) Remove(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	s.list.detachNode(node)
+	delete(s.items, key)
+	return node.value, true
+}
+
+// Entries yields the entries from most to least recently used, without
+// marking them as used.
+func (s *LRUCache[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := range s.list.all() {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LRUCache[K, V]) Stats() Stats {
+	return s.stats
+}
+
+// OnEvict registers a callback run for every entry the cache evicts. It is
+// not run for Remove, Clear or a Put that replaces a value.
+func (s *LRUCache[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	s.onEvict = callback
+}
+
+func (s *LRUCache[K, V]) evict(node *entry[K, V], reason EvictionReason) {
+	s.list.detachNode(node)
+	delete(s.items, node.key)
+	s.stats.Evictions++
+	if s.onEvict != nil {
+		s.onEvict(node.key, node.value, reason)
+	}
+}
@@ -0,0 +1,58 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func collectKeys[K comparable, V any](cache Cache[K, V]) []K {
+	keys := []K{}
+	for key := range cache.Entries() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache[string, int](2)
+	evicted := []string{}
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		require.Equal(t, Capacity, reason, "LRUCache eviction reason is not matched")
+		evicted = append(evicted, key)
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	value, ok := cache.Get("a")
+	require.True(t, ok, "LRUCache get(a) is not matched")
+	require.Equal(t, 1, value, "LRUCache get(a) is not equal")
+
+	cache.Put("c", 3)
+	require.Equal(t, []string{"b"}, evicted, "LRUCache evicted keys are not matched")
+	require.False(t, cache.ContainsKey("b"), "LRUCache contains(b) is not matched")
+	require.Equal(t, []string{"c", "a"}, collectKeys[string, int](cache), "LRUCache entries are not matched")
+
+	_, ok = cache.Peek("a")
+	require.True(t, ok, "LRUCache peek(a) is not matched")
+	cache.Put("c", 30)
+	cache.Put("d", 4)
+	require.Equal(t, []string{"b", "a"}, evicted, "LRUCache evicted keys are not matched")
+
+	_, ok = cache.Get("b")
+	require.False(t, ok, "LRUCache get(b) is not matched")
+	require.Equal(t, Stats{Hits: 1, Misses: 1, Evictions: 2}, cache.Stats(), "LRUCache stats are not equal")
+	require.Equal(t, 0.5, cache.Stats().HitRate(), "LRUCache hit rate is not equal")
+
+	value, ok = cache.Remove("c")
+	require.True(t, ok, "LRUCache remove(c) is not matched")
+	require.Equal(t, 30, value, "LRUCache remove(c) is not equal")
+	_, ok = cache.Remove("c")
+	require.False(t, ok, "LRUCache remove(c) twice is not matched")
+	require.Equal(t, 1, cache.Size(), "LRUCache size is not equal")
+	require.Equal(t, 2, cache.Capacity(), "LRUCache capacity is not equal")
+
+	cache.Clear()
+	require.True(t, cache.IsEmpty(), "LRUCache clear is not matched")
+	require.Equal(t, []string{"b", "a"}, evicted, "LRUCache clear ran the eviction callback")
+}
@@ -0,0 +1,211 @@
+package cache
+
+import (
+	"iter"
+	"time"
+)
+
+var _ Cache[int, int] = (*TTLCache[int, int])(nil)
+
+// TTLCache drops entries once they outlive their time to live. Expired entries
+// are removed lazily, when a lookup finds them, or all at once by
+// RemoveExpired; ConcurrentCache can call it in the background. A bounded
+// cache also evicts the least recently used entry to make room.
+type TTLCache[K comparable, V any] struct {
+	items    map[K]*entry[K, V]
+	list     *entryList[K, V]
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+	stats    Stats
+	onEvict  func(key K, value V, reason EvictionReason)
+}
+
+// NewTTLCache creates a cache whose entries live for ttl, or forever if ttl
+// is not positive. A capacity that is not positive leaves the cache unbounded.
+func NewTTLCache[K comparable, V any](capacity int, ttl time.Duration) *TTLCache[K, V] {
+	return &TTLCache[K, V]{
+		items:    map[K]*entry[K, V]{},
+		list:     newEntryList[K, V](),
+		capacity: max(capacity, 0),
+		ttl:      ttl,
+		now:      time.Now,
+	}
+}
+
+// Size returns the number of entries, including expired ones that have not
+// been removed yet.
+func (s *TTLCache[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *TTLCache[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+// Capacity returns the bound of the cache, or 0 if it is unbounded.
+func (s *TTLCache[K, V]) Capacity() int {
+	return s.capacity
+}
+
+func (s *TTLCache[K, V]) Clear() {
+	s.items = map[K]*entry[K, V]{}
+	s.list = newEntryList[K, V]()
+}
+
+// Put stores the value with the default time to live.
+func (s *TTLCache[K, V]) Put(key K, value V) {
+	s.PutWithTTL(key, value, s.ttl)
+}
+
+// PutWithTTL stores the value with its own time to live, or without expiry if
+// ttl is not positive. If a bounded cache is full, the least recently used
+// entry is evicted first.
+func (s *TTLCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = s.now().Add(ttl)
+	}
+
+	if node, ok := s.items[key]; ok {
+		node.value = value
+		node.expires = expires
+		s.list.moveToHead(node)
+		return
+	}
+
+	if s.capacity > 0 && len(s.items) >= s.capacity {
+		node := s.list.tailNode()
+		if s.expired(node, s.now()) {
+			s.evict(node, Expired)
+		} else {
+			s.evict(node, Capacity)
+		}
+	}
+
+	node := &entry[K, V]{key: key, value: value, expires: expires}
+	s.list.attachHeadNode(node)
+	s.items[key] = node
+}
+
+// Get returns the value if the key is present and not expired. Finding an
+// expired entry removes it and counts as a miss.
+func (s *TTLCache[K, V]) Get(key K) (V, bool) {
+	node, ok := s.lookup(key)
+	if !ok {
+		s.stats.Misses++
+		var zero V
+		return zero, false
+	}
+
+	s.stats.Hits++
+	s.list.moveToHead(node)
+	return node.value, true
+}
+
+func (s *TTLCache[K, V]) Peek(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok || s.expired(node, s.now()) {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+func (s *TTLCache[K, V]) ContainsKey(key K) bool {
+	_, ok := s.Peek(key)
+	return ok
+}
+
+func (s *TTLCache[K, V]) Remove(key K) (V, bool) {
+	node, ok := s.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	s.list.detachNode(node)
+	delete(s.items, key)
+	return node.value, true
+}
+
+// TTL returns how long the entry has left to live. An entry without expiry
+// reports a negative duration.
+func (s *TTLCache[K, V]) TTL(key K) (time.Duration, bool) {
+	node, ok := s.items[key]
+	now := s.now()
+	if !ok || s.expired(node, now) {
+		return 0, false
+	}
+	if node.expires.IsZero() {
+		return -1, true
+	}
+	return node.expires.Sub(now), true
+}
+
+// RemoveExpired removes every expired entry in O(n) and returns how many were
+// removed.
+func (s *TTLCache[K, V]) RemoveExpired() int {
+	now := s.now()
+	removed := 0
+	for node := range s.list.all() {
+		if s.expired(node, now) {
+			s.evict(node, Expired)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Entries yields the entries that have not expired, from most to least
+// recently used.
+func (s *TTLCache[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := s.now()
+		for node := range s.list.all() {
+			if !s.expired(node, now) && !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *TTLCache[K, V]) Stats() Stats {
+	return s.stats
+}
+
+// OnEvict registers a callback run for every entry the cache evicts or finds
+// expired. It is not run for Remove, Clear or a Put that replaces a value.
+func (s *TTLCache[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	s.onEvict = callback
+}
+
+// lookup returns the entry for key, removing it if it has expired.
+func (s *TTLCache[K, V]) lookup(key K) (*entry[K, V], bool) {
+	node, ok := s.items[key]
+	if !ok {
+		return nil, false
+	}
+	if s.expired(node, s.now()) {
+		s.evict(node, Expired)
+		return nil, false
+	}
+	return node, true
+}
+
+func (s *TTLCache[K, V]) expired(node *entry[K, V], now time.Time) bool {
+	return !node.expires.IsZero() && !now.Before(node.expires)
+}
+
+func (s *TTLCache[K, V]) evict(node *entry[K, V], reason EvictionReason) {
+	s.list.detachNode(node)
+	delete(s.items, node.key)
+	if reason == Expired {
+		s.stats.Expirations++
+	} else {
+		s.stats.Evictions++
+	}
+	if s.onEvict != nil {
+		s.onEvict(node.key, node.value, reason)
+	}
+}
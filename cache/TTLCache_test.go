@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func TestTTLCache(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewTTLCache[string, int](0, time.Minute)
+	cache.now = clock.Now
+	expired := []string{}
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		require.Equal(t, Expired, reason, "TTLCache eviction reason is not matched")
+		expired = append(expired, key)
+	})
+
+	cache.Put("a", 1)
+	cache.PutWithTTL("b", 2, 3*time.Minute)
+	cache.PutWithTTL("c", 3, 0)
+
+	clock.Advance(30 * time.Second)
+	ttl, ok := cache.TTL("a")
+	require.True(t, ok, "TTLCache ttl(a) is not matched")
+	require.Equal(t, 30*time.Second, ttl, "TTLCache ttl(a) is not equal")
+	ttl, _ = cache.TTL("c")
+	require.Negative(t, ttl, "TTLCache ttl(c) is not matched")
+
+	clock.Advance(30 * time.Second)
+	require.False(t, cache.ContainsKey("a"), "TTLCache contains(a) is not matched")
+	require.Equal(t, 3, cache.Size(), "TTLCache size before lazy expiry is not equal")
+	_, ok = cache.Get("a")
+	require.False(t, ok, "TTLCache get(a) is not matched")
+	require.Equal(t, []string{"a"}, expired, "TTLCache expired keys are not matched")
+	require.Equal(t, 2, cache.Size(), "TTLCache size after lazy expiry is not equal")
+
+	clock.Advance(5 * time.Minute)
+	require.Equal(t, []string{"c"}, collectKeys[string, int](cache), "TTLCache entries are not matched")
+	require.Equal(t, 1, cache.RemoveExpired(), "TTLCache remove expired is not equal")
+	require.Equal(t, []string{"a", "b"}, expired, "TTLCache expired keys are not matched")
+
+	value, ok := cache.Get("c")
+	require.True(t, ok, "TTLCache get(c) is not matched")
+	require.Equal(t, 3, value, "TTLCache get(c) is not equal")
+	require.Equal(t, Stats{Hits: 1, Misses: 1, Expirations: 2}, cache.Stats(), "TTLCache stats are not equal")
+}
+
+func TestTTLCache_Bounded(t *testing.T) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := NewTTLCache[string, int](2, time.Minute)
+	cache.now = clock.Now
+	reasons := map[string]EvictionReason{}
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		reasons[key] = reason
+	})
+
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("a")
+	cache.Put("c", 3)
+	require.Equal(t, map[string]EvictionReason{"b": Capacity}, reasons, "TTLCache eviction reasons are not matched")
+
+	clock.Advance(time.Minute)
+	cache.Put("d", 4)
+	require.Equal(t, Expired, reasons["a"], "TTLCache eviction reason of an expired tail is not matched")
+	require.Equal(t, 2, cache.Capacity(), "TTLCache capacity is not equal")
+
+	value, ok := cache.Remove("c")
+	require.False(t, ok, "TTLCache remove of an expired key is not matched")
+	require.Equal(t, 0, value, "TTLCache remove of an expired key is not equal")
+	require.Equal(t, []string{"d"}, collectKeys[string, int](cache), "TTLCache entries are not matched")
+}
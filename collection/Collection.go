@@ -0,0 +1,77 @@
+// Package collection holds the interfaces and errors shared by every
+// collection of the module. It stands in for a root package: the module path
+// go-utils is not a valid package name, so a root package would be imported
+// as "go-utils" but referred to by another name, while collection.List and
+// collection.ErrEmpty read the same at the import and at the call site. It
+// imports none of the other packages, so any of them can depend on it.
+package collection
+
+// Collection is the common behavior shared by every collection in this module.
+type Collection[T comparable] interface {
+	Size() int
+	IsEmpty() bool
+	Values() []T
+	Clear()
+	Contains(value T) bool
+}
+
+// List is an ordered collection with positional access.
+type List[T comparable] interface {
+	Collection[T]
+	Add(value T)
+	AddAll(values []T)
+	Get(index int) (T, error)
+	RemoveAt(index int) (T, error)
+	Reverse()
+	Sort(comparator func(T, T) int)
+}
+
+// Queue is a collection that hands out its elements one at a time through Poll.
+type Queue[T comparable] interface {
+	Collection[T]
+	Offer(value T)
+	OfferValues(values []T)
+	Poll() (T, error)
+	Peek() (T, error)
+}
+
+// Stack is a LIFO collection.
+type Stack[T comparable] interface {
+	Collection[T]
+	Push(value T)
+	PushValues(values []T)
+	Pop() (T, error)
+	Peek() (T, error)
+}
+
+// Deque is a collection that can be added to and removed from at both ends.
+type Deque[T comparable] interface {
+	Collection[T]
+	AddHead(value T)
+	AddTail(value T)
+	GetHead() (T, error)
+	GetTail() (T, error)
+	RemoveHead() (T, error)
+	RemoveTail() (T, error)
+}
+
+// Iterator is a fail-fast iterator: once its collection is modified other
+// than through Remove, HasNext returns false and Err reports
+// ErrConcurrentModification. Remove removes the value last returned by Next.
+type Iterator[T comparable] interface {
+	HasNext() bool
+	Next() T
+	Remove() error
+	Err() error
+}
+
+// SortedCollection keeps its elements ordered by a comparator; Peek and Poll
+// operate on the smallest element.
+type SortedCollection[T comparable] interface {
+	Collection[T]
+	Offer(value T)
+	OfferAll(values []T)
+	Remove(value T) bool
+	Peek() (T, error)
+	Poll() (T, error)
+}
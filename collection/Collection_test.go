@@ -0,0 +1,557 @@
+package collection_test
+
+import (
+	"go-utils/array"
+	"go-utils/collection"
+	"go-utils/deque"
+	"go-utils/list"
+	"go-utils/maps"
+	"go-utils/queue"
+	"go-utils/set"
+	"go-utils/stack"
+	"go-utils/tree"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intComparator(a, b int) int { return a - b }
+
+type collectionFactory struct {
+	name string
+	new  func(values []int) collection.Collection[int]
+}
+
+func collectionFactories() []collectionFactory {
+	factories := []collectionFactory{}
+	for _, f := range listFactories() {
+		factories = append(factories, collectionFactory{f.name, func(values []int) collection.Collection[int] { return f.new(values) }})
+	}
+	for _, f := range queueFactories() {
+		factories = append(factories, collectionFactory{f.name, func(values []int) collection.Collection[int] { return f.new(values) }})
+	}
+	for _, f := range stackFactories() {
+		factories = append(factories, collectionFactory{f.name, func(values []int) collection.Collection[int] { return f.new(values) }})
+	}
+	factories = append(factories, collectionFactory{"BoundedPriorityQueue", func(values []int) collection.Collection[int] {
+		q := queue.NewBoundedPriorityQueue[int](len(values)+1, intComparator)
+		q.OfferValues(values)
+		return q
+	}})
+	factories = append(factories, collectionFactory{"BinaryTree", func(values []int) collection.Collection[int] {
+		t := tree.NewBinaryTree[int](intComparator)
+		t.OfferAll(values)
+		return t
+	}})
+	return factories
+}
+
+type listFactory struct {
+	name string
+	new  func(values []int) collection.List[int]
+}
+
+func listFactories() []listFactory {
+	return []listFactory{
+		{"Array", func(values []int) collection.List[int] {
+			arr := array.NewArrayList[int]()
+			arr.AddAll(values)
+			return arr
+		}},
+		{"ConcurrentArray", func(values []int) collection.List[int] {
+			arr := array.NewConcurrentArray[int]()
+			arr.AddAll(values)
+			return arr
+		}},
+		{"LinkedList", func(values []int) collection.List[int] {
+			l := list.NewLinkedList[int]()
+			l.AddAll(values)
+			return l
+		}},
+		{"ConcurrentList", func(values []int) collection.List[int] {
+			l := list.NewConcurrentList[int]()
+			l.AddAll(values)
+			return l
+		}},
+		{"ArrayDeque", func(values []int) collection.List[int] {
+			d := deque.NewArrayDeque[int]()
+			d.AddAll(values)
+			return d
+		}},
+	}
+}
+
+type queueFactory struct {
+	name string
+	fifo bool
+	new  func(values []int) collection.Queue[int]
+}
+
+func queueFactories() []queueFactory {
+	return []queueFactory{
+		{"Queue", true, func(values []int) collection.Queue[int] {
+			q := queue.NewQueue[int]()
+			q.OfferValues(values)
+			return q
+		}},
+		{"ArrayQueue", true, func(values []int) collection.Queue[int] {
+			q := queue.NewArrayQueue[int]()
+			q.OfferValues(values)
+			return q
+		}},
+		{"ConcurrentQueue", true, func(values []int) collection.Queue[int] {
+			q := queue.NewConcurrentQueue[int]()
+			q.OfferValues(values)
+			return q
+		}},
+		{"PriorityQueue", false, func(values []int) collection.Queue[int] {
+			q := queue.NewPriorityQueue[int](intComparator)
+			q.OfferValues(values)
+			return q
+		}},
+		{"PairingHeap", false, func(values []int) collection.Queue[int] {
+			q := queue.NewPairingHeap[int](intComparator)
+			q.OfferValues(values)
+			return q
+		}},
+		{"MinMaxHeap", false, func(values []int) collection.Queue[int] {
+			q := queue.NewMinMaxHeap[int](intComparator)
+			q.OfferValues(values)
+			return q
+		}},
+		{"ConcurrentPriorityQueue", false, func(values []int) collection.Queue[int] {
+			q := queue.NewConcurrentPriorityQueue[int](intComparator)
+			q.OfferValues(values)
+			return q
+		}},
+	}
+}
+
+type stackFactory struct {
+	name string
+	new  func(values []int) collection.Stack[int]
+}
+
+func stackFactories() []stackFactory {
+	return []stackFactory{
+		{"Stack", func(values []int) collection.Stack[int] {
+			s := stack.NewStack[int]()
+			s.PushValues(values)
+			return s
+		}},
+		{"ArrayStack", func(values []int) collection.Stack[int] {
+			s := stack.NewArrayStack[int]()
+			s.PushValues(values)
+			return s
+		}},
+		{"ConcurrentStack", func(values []int) collection.Stack[int] {
+			s := stack.NewConcurrentStack[int]()
+			s.PushValues(values)
+			return s
+		}},
+	}
+}
+
+type dequeFactory struct {
+	name string
+	new  func() collection.Deque[int]
+}
+
+func dequeFactories() []dequeFactory {
+	return []dequeFactory{
+		{"LinkedList", func() collection.Deque[int] { return list.NewLinkedList[int]() }},
+		{"ConcurrentList", func() collection.Deque[int] { return list.NewConcurrentList[int]() }},
+		{"Queue", func() collection.Deque[int] { return queue.NewQueue[int]() }},
+		{"Stack", func() collection.Deque[int] { return stack.NewStack[int]() }},
+		{"ArrayDeque", func() collection.Deque[int] { return deque.NewArrayDeque[int]() }},
+		{"ArrayQueue", func() collection.Deque[int] { return queue.NewArrayQueue[int]() }},
+		{"ArrayStack", func() collection.Deque[int] { return stack.NewArrayStack[int]() }},
+	}
+}
+
+func TestCollection(t *testing.T) {
+	for _, f := range collectionFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			empty := f.new(nil)
+			require.True(t, empty.IsEmpty(), "%s is not empty", f.name)
+			require.Equal(t, 0, empty.Size(), "%s size is not equal", f.name)
+			require.Empty(t, empty.Values(), "%s values are not empty", f.name)
+			require.False(t, empty.Contains(1), "%s contains(1) is not matched", f.name)
+
+			c := f.new([]int{3, 1, 4, 1, 5})
+			require.False(t, c.IsEmpty(), "%s is empty", f.name)
+			require.Equal(t, 5, c.Size(), "%s size is not equal", f.name)
+			require.ElementsMatch(t, []int{1, 1, 3, 4, 5}, c.Values(), "%s values are not equal", f.name)
+			require.True(t, c.Contains(4), "%s contains(4) is not matched", f.name)
+			require.False(t, c.Contains(2), "%s contains(2) is not matched", f.name)
+
+			c.Clear()
+			require.True(t, c.IsEmpty(), "%s is not empty", f.name)
+			require.Equal(t, 0, c.Size(), "%s size is not equal", f.name)
+			require.Empty(t, c.Values(), "%s values are not empty", f.name)
+			require.False(t, c.Contains(4), "%s contains(4) is not matched", f.name)
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	for _, f := range listFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			l := f.new(nil)
+			l.Add(1)
+			l.AddAll([]int{2, 3, 4})
+			require.Equal(t, []int{1, 2, 3, 4}, l.Values(), "%s values are not equal", f.name)
+
+			value, err := l.Get(2)
+			require.Nil(t, err, "%s get is failed", f.name)
+			require.Equal(t, 3, value, "%s get(2) is not matched", f.name)
+
+			_, err = l.Get(4)
+			require.NotNil(t, err, "%s get(4) is not failed", f.name)
+			_, err = l.Get(-1)
+			require.NotNil(t, err, "%s get(-1) is not failed", f.name)
+
+			value, err = l.RemoveAt(1)
+			require.Nil(t, err, "%s remove is failed", f.name)
+			require.Equal(t, 2, value, "%s remove(1) is not matched", f.name)
+			require.Equal(t, []int{1, 3, 4}, l.Values(), "%s values are not equal", f.name)
+
+			_, err = l.RemoveAt(3)
+			require.NotNil(t, err, "%s remove(3) is not failed", f.name)
+
+			l.Reverse()
+			require.Equal(t, []int{4, 3, 1}, l.Values(), "%s values are not equal", f.name)
+
+			l.Sort(intComparator)
+			require.Equal(t, []int{1, 3, 4}, l.Values(), "%s values are not equal", f.name)
+		})
+	}
+}
+
+func TestQueue(t *testing.T) {
+	for _, f := range queueFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			q := f.new(nil)
+			_, err := q.Poll()
+			require.NotNil(t, err, "%s poll is not failed", f.name)
+			_, err = q.Peek()
+			require.NotNil(t, err, "%s peek is not failed", f.name)
+
+			q.Offer(1)
+			q.OfferValues([]int{2, 3})
+			require.Equal(t, 3, q.Size(), "%s size is not equal", f.name)
+
+			polled := []int{}
+			for !q.IsEmpty() {
+				peeked, err := q.Peek()
+				require.Nil(t, err, "%s peek is failed", f.name)
+				value, err := q.Poll()
+				require.Nil(t, err, "%s poll is failed", f.name)
+				require.Equal(t, peeked, value, "%s peek and poll are not matched", f.name)
+				polled = append(polled, value)
+			}
+			if f.fifo {
+				require.Equal(t, []int{1, 2, 3}, polled, "%s poll order is not matched", f.name)
+			} else {
+				require.ElementsMatch(t, []int{1, 2, 3}, polled, "%s polled values are not matched", f.name)
+			}
+		})
+	}
+
+	for _, f := range queueFactories() {
+		if f.fifo {
+			continue
+		}
+
+		t.Run(f.name+"_Priority", func(t *testing.T) {
+			q := f.new([]int{5, 3, 8, 1, 9, 2})
+			polled := []int{}
+			for !q.IsEmpty() {
+				value, _ := q.Poll()
+				polled = append(polled, value)
+			}
+			require.Equal(t, []int{1, 2, 3, 5, 8, 9}, polled, "%s poll order is not matched", f.name)
+		})
+	}
+}
+
+func TestStack(t *testing.T) {
+	for _, f := range stackFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			s := f.new(nil)
+			_, err := s.Pop()
+			require.NotNil(t, err, "%s pop is not failed", f.name)
+			_, err = s.Peek()
+			require.NotNil(t, err, "%s peek is not failed", f.name)
+
+			s.Push(1)
+			s.PushValues([]int{2, 3})
+			require.Equal(t, 3, s.Size(), "%s size is not equal", f.name)
+
+			popped := []int{}
+			for !s.IsEmpty() {
+				peeked, err := s.Peek()
+				require.Nil(t, err, "%s peek is failed", f.name)
+				value, err := s.Pop()
+				require.Nil(t, err, "%s pop is failed", f.name)
+				require.Equal(t, peeked, value, "%s peek and pop are not matched", f.name)
+				popped = append(popped, value)
+			}
+			require.Equal(t, []int{3, 2, 1}, popped, "%s pop order is not matched", f.name)
+		})
+	}
+}
+
+func TestDeque(t *testing.T) {
+	for _, f := range dequeFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			d := f.new()
+			_, err := d.GetHead()
+			require.NotNil(t, err, "%s get head is not failed", f.name)
+			_, err = d.RemoveTail()
+			require.NotNil(t, err, "%s remove tail is not failed", f.name)
+
+			d.AddTail(2)
+			d.AddHead(1)
+			d.AddTail(3)
+			require.Equal(t, []int{1, 2, 3}, d.Values(), "%s values are not equal", f.name)
+
+			head, err := d.GetHead()
+			require.Nil(t, err, "%s get head is failed", f.name)
+			require.Equal(t, 1, head, "%s head is not matched", f.name)
+			tail, err := d.GetTail()
+			require.Nil(t, err, "%s get tail is failed", f.name)
+			require.Equal(t, 3, tail, "%s tail is not matched", f.name)
+
+			head, _ = d.RemoveHead()
+			tail, _ = d.RemoveTail()
+			require.Equal(t, 1, head, "%s removed head is not matched", f.name)
+			require.Equal(t, 3, tail, "%s removed tail is not matched", f.name)
+			require.Equal(t, []int{2}, d.Values(), "%s values are not equal", f.name)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	for _, f := range queueFactories() {
+		t.Run(f.name+"_Queue", func(t *testing.T) {
+			q := f.new(nil)
+			_, err := q.Poll()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s poll error is not matched", f.name)
+			_, err = q.Peek()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s peek error is not matched", f.name)
+		})
+	}
+	for _, f := range stackFactories() {
+		t.Run(f.name+"_Stack", func(t *testing.T) {
+			s := f.new(nil)
+			_, err := s.Pop()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s pop error is not matched", f.name)
+			_, err = s.Peek()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s peek error is not matched", f.name)
+		})
+	}
+	for _, f := range dequeFactories() {
+		t.Run(f.name+"_Deque", func(t *testing.T) {
+			d := f.new()
+			_, err := d.GetHead()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s get head error is not matched", f.name)
+			_, err = d.RemoveTail()
+			require.ErrorIs(t, err, collection.ErrEmpty, "%s remove tail error is not matched", f.name)
+		})
+	}
+	for _, f := range listFactories() {
+		t.Run(f.name+"_List", func(t *testing.T) {
+			l := f.new([]int{1, 2, 3})
+			for _, index := range []int{-1, 3} {
+				_, err := l.Get(index)
+				require.ErrorIs(t, err, collection.ErrIndexOutOfRange, "%s get(%d) error is not matched", f.name, index)
+
+				var indexErr *collection.IndexError
+				require.ErrorAs(t, err, &indexErr, "%s get(%d) error is not an IndexError", f.name, index)
+				require.Equal(t, index, indexErr.Index, "%s error index is not equal", f.name)
+				require.Equal(t, 3, indexErr.Size, "%s error size is not equal", f.name)
+			}
+		})
+	}
+
+	t.Run("Must", func(t *testing.T) {
+		require.Equal(t, 1, collection.Must(1, nil), "must value is not equal")
+		require.Panics(t, func() { collection.Must(0, collection.ErrEmpty) }, "must is not panicked")
+
+		arr := array.NewArrayList[int]()
+		arr.Add(1)
+		require.Equal(t, 1, arr.MustGet(0), "must get is not equal")
+		require.Panics(t, func() { arr.MustGet(1) }, "must get is not panicked")
+		require.Panics(t, func() { stack.NewStack[int]().MustPop() }, "must pop is not panicked")
+		require.Panics(t, func() { queue.NewQueue[int]().MustPoll() }, "must poll is not panicked")
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		q := queue.NewBlockingQueue[int](1)
+		q.Close()
+		err := q.Put(1)
+		require.ErrorIs(t, err, collection.ErrClosed, "put error is not matched")
+		require.ErrorIs(t, err, queue.ErrQueueClosed, "put error is not matched")
+	})
+}
+
+type sortedFactory struct {
+	name string
+	new  func() collection.SortedCollection[int]
+}
+
+func sortedFactories() []sortedFactory {
+	return []sortedFactory{
+		{"BinaryTree", func() collection.SortedCollection[int] { return tree.NewBinaryTree[int](intComparator) }},
+		{"TreeSet", func() collection.SortedCollection[int] { return tree.NewTreeSet[int](intComparator) }},
+	}
+}
+
+func TestSortedCollection(t *testing.T) {
+	for _, f := range sortedFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			sorted := f.new()
+			_, err := sorted.Poll()
+			require.NotNil(t, err, "%s poll is not failed", f.name)
+
+			sorted.OfferAll([]int{5, 3, 8})
+			sorted.Offer(1)
+			require.Equal(t, []int{1, 3, 5, 8}, sorted.Values(), "%s values are not equal", f.name)
+			require.True(t, sorted.Remove(5), "%s remove(5) is not matched", f.name)
+			require.False(t, sorted.Remove(5), "%s remove(5) is not matched", f.name)
+
+			value, err := sorted.Peek()
+			require.Nil(t, err, "%s peek is failed", f.name)
+			require.Equal(t, 1, value, "%s peek is not matched", f.name)
+			value, err = sorted.Poll()
+			require.Nil(t, err, "%s poll is failed", f.name)
+			require.Equal(t, 1, value, "%s poll is not matched", f.name)
+			require.Equal(t, []int{3, 8}, sorted.Values(), "%s values are not equal", f.name)
+		})
+	}
+}
+
+type setFactory struct {
+	name string
+	new  func() collection.Set[int]
+}
+
+func setFactories() []setFactory {
+	return []setFactory{
+		{"HashSet", func() collection.Set[int] { return set.NewHashSet[int]() }},
+		{"LinkedHashSet", func() collection.Set[int] { return set.NewLinkedHashSet[int]() }},
+		{"SortedSet", func() collection.Set[int] { return set.NewSortedSet[int](intComparator) }},
+	}
+}
+
+func TestSet(t *testing.T) {
+	for _, f := range setFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			s := f.new()
+			require.True(t, s.IsEmpty(), "%s is not empty", f.name)
+			require.True(t, s.Add(3), "%s add(3) is not matched", f.name)
+			require.False(t, s.Add(3), "%s add(3) is not matched", f.name)
+			s.AddAll([]int{1, 3, 2, 1})
+			require.Equal(t, 3, s.Size(), "%s size is not equal", f.name)
+			require.ElementsMatch(t, []int{1, 2, 3}, s.Values(), "%s values are not equal", f.name)
+			require.ElementsMatch(t, []int{1, 2, 3}, slices.Collect(s.All()), "%s all is not matched", f.name)
+			require.True(t, s.Contains(2), "%s contains(2) is not matched", f.name)
+
+			require.True(t, s.Remove(2), "%s remove(2) is not matched", f.name)
+			require.False(t, s.Remove(2), "%s remove(2) is not matched", f.name)
+			require.False(t, s.Contains(2), "%s contains(2) is not matched", f.name)
+
+			s.Clear()
+			require.True(t, s.IsEmpty(), "%s is not empty", f.name)
+			require.Empty(t, s.Values(), "%s values are not empty", f.name)
+		})
+	}
+}
+
+type mapFactory struct {
+	name string
+	new  func() collection.Map[string, int]
+}
+
+func mapFactories() []mapFactory {
+	return []mapFactory{
+		{"HashMap", func() collection.Map[string, int] { return maps.NewHashMap[string, int]() }},
+		{"LinkedHashMap", func() collection.Map[string, int] { return maps.NewLinkedHashMap[string, int]() }},
+		{"AccessOrderLinkedHashMap", func() collection.Map[string, int] { return maps.NewAccessOrderLinkedHashMap[string, int]() }},
+		{"ConcurrentHashMap", func() collection.Map[string, int] { return maps.NewConcurrentHashMap[string, int]() }},
+		{"TreeMap", func() collection.Map[string, int] { return tree.NewTreeMap[string, int](strings.Compare) }},
+	}
+}
+
+func TestMap(t *testing.T) {
+	sum := func(a, b int) (int, bool) { return a + b, true }
+
+	for _, f := range mapFactories() {
+		t.Run(f.name, func(t *testing.T) {
+			m := f.new()
+			require.True(t, m.IsEmpty(), "%s is not empty", f.name)
+			_, ok := m.Get("a")
+			require.False(t, ok, "%s get(a) is not matched", f.name)
+			require.Equal(t, -1, m.GetOrDefault("a", -1), "%s get or default is not matched", f.name)
+
+			m.Put("a", 1)
+			m.Put("b", 2)
+			m.Put("a", 10)
+			require.Equal(t, 2, m.Size(), "%s size is not equal", f.name)
+			value, ok := m.Get("a")
+			require.True(t, ok, "%s get(a) is failed", f.name)
+			require.Equal(t, 10, value, "%s get(a) is not matched", f.name)
+			require.True(t, m.ContainsKey("b"), "%s contains key(b) is not matched", f.name)
+
+			value, loaded := m.PutIfAbsent("b", 20)
+			require.True(t, loaded, "%s put if absent(b) is not matched", f.name)
+			require.Equal(t, 2, value, "%s put if absent(b) value is not matched", f.name)
+			value, loaded = m.PutIfAbsent("c", 3)
+			require.False(t, loaded, "%s put if absent(c) is not matched", f.name)
+			require.Equal(t, 3, value, "%s put if absent(c) value is not matched", f.name)
+
+			calls := 0
+			compute := func(string) int { calls++; return 4 }
+			require.Equal(t, 4, m.ComputeIfAbsent("d", compute), "%s compute if absent is not matched", f.name)
+			require.Equal(t, 4, m.ComputeIfAbsent("d", compute), "%s compute if absent is not matched", f.name)
+			require.Equal(t, 1, calls, "%s compute if absent calls are not matched", f.name)
+
+			value, ok = m.ComputeIfPresent("d", func(_ string, v int) (int, bool) { return v * 10, true })
+			require.True(t, ok, "%s compute if present is failed", f.name)
+			require.Equal(t, 40, value, "%s compute if present is not matched", f.name)
+			_, ok = m.ComputeIfPresent("x", func(_ string, v int) (int, bool) { return v, true })
+			require.False(t, ok, "%s compute if present(x) is not matched", f.name)
+			_, ok = m.ComputeIfPresent("d", func(string, int) (int, bool) { return 0, false })
+			require.False(t, ok, "%s compute if present removal is not matched", f.name)
+			require.False(t, m.ContainsKey("d"), "%s contains key(d) is not matched", f.name)
+
+			value, _ = m.Merge("c", 5, sum)
+			require.Equal(t, 8, value, "%s merge(c) is not matched", f.name)
+			value, _ = m.Merge("e", 5, sum)
+			require.Equal(t, 5, value, "%s merge(e) is not matched", f.name)
+			_, ok = m.Merge("e", 5, func(int, int) (int, bool) { return 0, false })
+			require.False(t, ok, "%s merge removal is not matched", f.name)
+
+			require.ElementsMatch(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()), "%s keys are not matched", f.name)
+			require.ElementsMatch(t, []int{10, 2, 8}, slices.Collect(m.Values()), "%s values are not matched", f.name)
+			entries := map[string]int{}
+			for key, value := range m.Entries() {
+				entries[key] = value
+			}
+			require.Equal(t, map[string]int{"a": 10, "b": 2, "c": 8}, entries, "%s entries are not matched", f.name)
+
+			value, ok = m.Remove("a")
+			require.True(t, ok, "%s remove(a) is failed", f.name)
+			require.Equal(t, 10, value, "%s remove(a) is not matched", f.name)
+			_, ok = m.Remove("a")
+			require.False(t, ok, "%s remove(a) is not matched", f.name)
+
+			m.Clear()
+			require.True(t, m.IsEmpty(), "%s is not empty", f.name)
+			require.Equal(t, 0, m.Size(), "%s size is not equal", f.name)
+		})
+	}
+}
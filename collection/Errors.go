@@ -0,0 +1,56 @@
+package collection
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEmpty is returned when reading or removing from an empty collection.
+	ErrEmpty = errors.New("collection is empty")
+	// ErrIndexOutOfRange matches every IndexError through errors.Is.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrCapacityExceeded is returned when a bounded collection has no room
+	// for another value.
+	ErrCapacityExceeded = errors.New("capacity exceeded")
+	// ErrClosed is returned by a blocking collection once it has been closed.
+	ErrClosed = errors.New("collection is closed")
+	// ErrInvalidHandle is returned when a handle refers to an entry that is
+	// no longer in its collection.
+	ErrInvalidHandle = errors.New("handle is not in the collection")
+	// ErrInvalidArgument is wrapped by errors for arguments that break the
+	// contract of a method.
+	ErrInvalidArgument = errors.New("invalid argument")
+
+	// ErrConcurrentModification is reported by an iterator whose collection
+	// was structurally modified other than through the iterator itself.
+	ErrConcurrentModification = errors.New("collection was modified during iteration")
+	// ErrIllegalState is returned by an iterator's Remove when Next has not
+	// been called since the last Remove.
+	ErrIllegalState = errors.New("iterator has no current value")
+)
+
+// IndexError reports an index outside of a collection of the given size.
+// errors.Is matches it with ErrIndexOutOfRange, and errors.As extracts it.
+type IndexError struct {
+	Index int
+	Size  int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("index %d is out of range with size %d", e.Index, e.Size)
+}
+
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
+
+// Must returns the value, or panics with err if it is not nil. It unwraps the
+// result of Get, Peek, Poll or Pop where a failure can only be a bug, as the
+// Must methods of the collections do.
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
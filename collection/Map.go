@@ -0,0 +1,25 @@
+package collection
+
+import "iter"
+
+// Map associates keys with values.
+//
+// The remapping functions of ComputeIfPresent and Merge return false to remove
+// the key instead of storing a value.
+type Map[K comparable, V any] interface {
+	Size() int
+	IsEmpty() bool
+	Clear()
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	GetOrDefault(key K, defaultValue V) V
+	ContainsKey(key K) bool
+	Remove(key K) (V, bool)
+	PutIfAbsent(key K, value V) (V, bool)
+	ComputeIfAbsent(key K, mapping func(K) V) V
+	ComputeIfPresent(key K, remapping func(K, V) (V, bool)) (V, bool)
+	Merge(key K, value V, remapping func(V, V) (V, bool)) (V, bool)
+	Keys() iter.Seq[K]
+	Values() iter.Seq[V]
+	Entries() iter.Seq2[K, V]
+}
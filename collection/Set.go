@@ -0,0 +1,13 @@
+package collection
+
+import "iter"
+
+// Set is a collection without duplicates. Add and Remove report whether the
+// set changed.
+type Set[T comparable] interface {
+	Collection[T]
+	Add(value T) bool
+	AddAll(values []T)
+	Remove(value T) bool
+	All() iter.Seq[T]
+}
@@ -0,0 +1,329 @@
+package deque
+
+import (
+	"go-utils/collection"
+	"iter"
+	"slices"
+)
+
+var (
+	_ collection.List[int]  = (*ArrayDeque[int])(nil)
+	_ collection.Deque[int] = (*ArrayDeque[int])(nil)
+)
+
+const minCapacity = 8
+
+// ArrayDeque is a double-ended queue on a circular buffer. Adding and removing
+// at either end and access by index are O(1); inserting or removing in the
+// middle shifts the shorter side.
+//
+// The capacity is a power of two. It doubles when the buffer is full and
+// halves when the deque drops to a quarter of it, never going below the
+// initial capacity.
+type ArrayDeque[T comparable] struct {
+	items    []T
+	head     int
+	size     int
+	capacity int
+	modCount int
+}
+
+func NewArrayDeque[T comparable]() *ArrayDeque[T] {
+	return NewArrayDequeWithCapacity[T](minCapacity)
+}
+
+// NewArrayDequeWithCapacity creates a deque that holds capacity values, rounded
+// up to a power of two, before it grows.
+func NewArrayDequeWithCapacity[T comparable](capacity int) *ArrayDeque[T] {
+	capacity = roundUp(max(capacity, minCapacity))
+	return &ArrayDeque[T]{items: make([]T, capacity), capacity: capacity}
+}
+
+func (s *ArrayDeque[T]) Size() int {
+	return s.size
+}
+
+func (s *ArrayDeque[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// ModCount returns how many times the deque has changed size or been sorted
+// or reversed. Iterators compare it with the count they started from to
+// detect modifications.
+func (s *ArrayDeque[T]) ModCount() int {
+	return s.modCount
+}
+
+// Capacity returns the length of the underlying buffer.
+func (s *ArrayDeque[T]) Capacity() int {
+	return len(s.items)
+}
+
+func (s *ArrayDeque[T]) Clear() {
+	s.modCount++
+	s.items = make([]T, s.capacity)
+	s.head = 0
+	s.size = 0
+}
+
+func (s *ArrayDeque[T]) Values() []T {
+	values := make([]T, s.size)
+	for i := range values {
+		values[i] = s.items[s.index(i)]
+	}
+	return values
+}
+
+func (s *ArrayDeque[T]) Add(value T) {
+	s.AddTail(value)
+}
+
+func (s *ArrayDeque[T]) AddAll(values []T) {
+	for _, value := range values {
+		s.AddTail(value)
+	}
+}
+
+func (s *ArrayDeque[T]) AddHead(value T) {
+	s.modCount++
+	s.grow()
+	s.head = (s.head - 1) & (len(s.items) - 1)
+	s.items[s.head] = value
+	s.size++
+}
+
+func (s *ArrayDeque[T]) AddTail(value T) {
+	s.modCount++
+	s.grow()
+	s.items[s.index(s.size)] = value
+	s.size++
+}
+
+// InsertAt inserts the value before the given index. Like
+// list.LinkedList.InsertAt, indexes out of range insert at the nearest end.
+func (s *ArrayDeque[T]) InsertAt(index int, value T) {
+	if index <= 0 {
+		s.AddHead(value)
+		return
+	}
+	if index >= s.size {
+		s.AddTail(value)
+		return
+	}
+
+	s.modCount++
+	s.grow()
+	if index < s.size/2 {
+		s.head = (s.head - 1) & (len(s.items) - 1)
+		for i := 0; i < index; i++ {
+			s.items[s.index(i)] = s.items[s.index(i+1)]
+		}
+	} else {
+		for i := s.size; i > index; i-- {
+			s.items[s.index(i)] = s.items[s.index(i-1)]
+		}
+	}
+	s.items[s.index(index)] = value
+	s.size++
+}
+
+func (s *ArrayDeque[T]) GetHead() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return s.items[s.head], nil
+}
+
+func (s *ArrayDeque[T]) GetTail() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return s.items[s.index(s.size-1)], nil
+}
+
+func (s *ArrayDeque[T]) GetAt(index int) (T, error) {
+	if index < 0 || index >= s.size {
+		var zero T
+		return zero, &collection.IndexError{Index: index, Size: s.size}
+	}
+	return s.items[s.index(index)], nil
+}
+
+func (s *ArrayDeque[T]) Get(index int) (T, error) {
+	return s.GetAt(index)
+}
+
+// MustGet is like Get but panics on error.
+func (s *ArrayDeque[T]) MustGet(index int) T {
+	return collection.Must(s.Get(index))
+}
+
+func (s *ArrayDeque[T]) SetAt(index int, value T) bool {
+	if index < 0 || index >= s.size {
+		return false
+	}
+
+	s.items[s.index(index)] = value
+	return true
+}
+
+func (s *ArrayDeque[T]) RemoveHead() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	var zero T
+	s.modCount++
+	value := s.items[s.head]
+	s.items[s.head] = zero
+	s.head = (s.head + 1) & (len(s.items) - 1)
+	s.size--
+	s.shrink()
+	return value, nil
+}
+
+func (s *ArrayDeque[T]) RemoveTail() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	var zero T
+	s.modCount++
+	tail := s.index(s.size - 1)
+	value := s.items[tail]
+	s.items[tail] = zero
+	s.size--
+	s.shrink()
+	return value, nil
+}
+
+func (s *ArrayDeque[T]) RemoveAt(index int) (T, error) {
+	value, err := s.GetAt(index)
+	if err != nil {
+		return value, err
+	}
+
+	s.modCount++
+	var zero T
+	if index < s.size/2 {
+		for i := index; i > 0; i-- {
+			s.items[s.index(i)] = s.items[s.index(i-1)]
+		}
+		s.items[s.head] = zero
+		s.head = (s.head + 1) & (len(s.items) - 1)
+	} else {
+		for i := index; i < s.size-1; i++ {
+			s.items[s.index(i)] = s.items[s.index(i+1)]
+		}
+		s.items[s.index(s.size-1)] = zero
+	}
+	s.size--
+	s.shrink()
+	return value, nil
+}
+
+func (s *ArrayDeque[T]) Contains(value T) bool {
+	for i := 0; i < s.size; i++ {
+		if s.items[s.index(i)] == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ArrayDeque[T]) Reverse() {
+	s.modCount++
+	for i, j := 0, s.size-1; i < j; i, j = i+1, j-1 {
+		left, right := s.index(i), s.index(j)
+		s.items[left], s.items[right] = s.items[right], s.items[left]
+	}
+}
+
+func (s *ArrayDeque[T]) Sort(comparator func(T, T) int) {
+	s.modCount++
+	values := s.Values()
+	slices.SortStableFunc(values, comparator)
+	s.resize(len(s.items), values)
+}
+
+func (s *ArrayDeque[T]) Clone() *ArrayDeque[T] {
+	clone := &ArrayDeque[T]{capacity: s.capacity}
+	clone.resize(len(s.items), s.Values())
+	return clone
+}
+
+func (s *ArrayDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < s.size; i++ {
+			if !yield(s.items[s.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ArrayDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := s.size - 1; i >= 0; i-- {
+			if !yield(s.items[s.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ArrayDeque[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < s.size; i++ {
+			if !yield(i, s.items[s.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func Collect[T comparable](seq iter.Seq[T]) *ArrayDeque[T] {
+	result := NewArrayDeque[T]()
+	for value := range seq {
+		result.AddTail(value)
+	}
+	return result
+}
+
+// index maps a position in the deque to a slot in the buffer.
+func (s *ArrayDeque[T]) index(i int) int {
+	return (s.head + i) & (len(s.items) - 1)
+}
+
+func (s *ArrayDeque[T]) grow() {
+	if s.size == len(s.items) {
+		s.resize(len(s.items)*2, s.Values())
+	}
+}
+
+func (s *ArrayDeque[T]) shrink() {
+	if len(s.items) > s.capacity && s.size <= len(s.items)/4 {
+		s.resize(len(s.items)/2, s.Values())
+	}
+}
+
+// resize replaces the buffer with one of the given capacity holding values
+// from slot zero.
+func (s *ArrayDeque[T]) resize(capacity int, values []T) {
+	s.items = make([]T, capacity)
+	copy(s.items, values)
+	s.head = 0
+	s.size = len(values)
+}
+
+func roundUp(capacity int) int {
+	result := 1
+	for result < capacity {
+		result <<= 1
+	}
+	return result
+}
@@ -0,0 +1,108 @@
+package deque
+
+import "go-utils/collection"
+
+var (
+	_ collection.Iterator[int] = (*Iterator[int])(nil)
+	_ collection.Iterator[int] = (*ReverseIterator[int])(nil)
+)
+
+// Iterator walks an ArrayDeque from the head to the tail in O(1) per step. It
+// fails fast: once the deque is modified other than through Remove, HasNext
+// returns false and Err reports collection.ErrConcurrentModification.
+type Iterator[T comparable] struct {
+	deque *ArrayDeque[T]
+	index int
+	// step is 1 when walking from the head and -1 when walking from the tail.
+	step     int
+	last     int
+	modCount int
+	err      error
+}
+
+func (s *ArrayDeque[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{deque: s, index: 0, step: 1, last: -1, modCount: s.modCount}
+}
+
+func (it *Iterator[T]) HasNext() bool {
+	return it.check() == nil && it.index >= 0 && it.index < it.deque.size
+}
+
+// Next returns the next value, or the zero value if there is none or the
+// deque was modified.
+func (it *Iterator[T]) Next() T {
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
+	value := it.deque.items[it.deque.index(it.index)]
+	it.last = it.index
+	it.index += it.step
+	return value
+}
+
+// Remove removes the value last returned by Next.
+func (it *Iterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last < 0 {
+		return collection.ErrIllegalState
+	}
+
+	it.deque.RemoveAt(it.last)
+	if it.step > 0 {
+		// the following values moved down by one
+		it.index = it.last
+	}
+	it.last = -1
+	it.modCount = it.deque.modCount
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the deque.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) check() error {
+	if it.err == nil && it.modCount != it.deque.modCount {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
+}
+
+func (it *Iterator[T]) Each(action func(T)) {
+	for it.HasNext() {
+		action(it.Next())
+	}
+}
+
+// ReverseIterator walks an ArrayDeque from the tail to the head. It fails fast
+// like Iterator.
+type ReverseIterator[T comparable] struct {
+	it *Iterator[T]
+}
+
+func (s *ArrayDeque[T]) ReverseIterator() *ReverseIterator[T] {
+	return &ReverseIterator[T]{&Iterator[T]{deque: s, index: s.size - 1, step: -1, last: -1, modCount: s.modCount}}
+}
+
+func (it *ReverseIterator[T]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+func (it *ReverseIterator[T]) Next() T {
+	return it.it.Next()
+}
+
+// Remove removes the value last returned by Next.
+func (it *ReverseIterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+func (it *ReverseIterator[T]) Err() error {
+	return it.it.Err()
+}
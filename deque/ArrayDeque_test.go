@@ -0,0 +1,189 @@
+package deque
+
+import (
+	"go-utils/collection"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArrayDeque(t *testing.T) {
+	d := NewArrayDeque[int]()
+	_, err := d.RemoveHead()
+	require.NotNil(t, err, "ArrayDeque remove head is not failed")
+	_, err = d.GetTail()
+	require.NotNil(t, err, "ArrayDeque get tail is not failed")
+
+	d.AddTail(3)
+	d.AddHead(2)
+	d.AddHead(1)
+	d.AddAll([]int{4, 5})
+	require.Equal(t, []int{1, 2, 3, 4, 5}, d.Values(), "ArrayDeque values are not equal")
+
+	value, err := d.GetAt(3)
+	require.Nil(t, err, "ArrayDeque get at(3) is failed")
+	require.Equal(t, 4, value, "ArrayDeque get at(3) is not matched")
+	_, err = d.GetAt(5)
+	require.NotNil(t, err, "ArrayDeque get at(5) is not failed")
+	require.True(t, d.SetAt(0, 10), "ArrayDeque set at(0) is failed")
+	require.False(t, d.SetAt(5, 10), "ArrayDeque set at(5) is not failed")
+
+	d.InsertAt(1, 11)
+	d.InsertAt(5, 12)
+	d.InsertAt(-1, 0)
+	d.InsertAt(100, 99)
+	require.Equal(t, []int{0, 10, 11, 2, 3, 4, 12, 5, 99}, d.Values(), "ArrayDeque values are not equal")
+
+	value, _ = d.RemoveAt(2)
+	require.Equal(t, 11, value, "ArrayDeque remove at(2) is not matched")
+	value, _ = d.RemoveAt(5)
+	require.Equal(t, 12, value, "ArrayDeque remove at(5) is not matched")
+	value, _ = d.RemoveHead()
+	require.Equal(t, 0, value, "ArrayDeque remove head is not matched")
+	value, _ = d.RemoveTail()
+	require.Equal(t, 99, value, "ArrayDeque remove tail is not matched")
+	require.Equal(t, []int{10, 2, 3, 4, 5}, d.Values(), "ArrayDeque values are not equal")
+
+	d.Reverse()
+	require.Equal(t, []int{5, 4, 3, 2, 10}, slices.Collect(d.All()), "ArrayDeque reverse is not matched")
+	d.Sort(func(a, b int) int { return a - b })
+	require.Equal(t, []int{2, 3, 4, 5, 10}, d.Values(), "ArrayDeque sort is not matched")
+	require.Equal(t, []int{10, 5, 4, 3, 2}, slices.Collect(d.Backward()), "ArrayDeque backward is not matched")
+	require.True(t, d.Contains(5), "ArrayDeque contains(5) is not matched")
+
+	clone := d.Clone()
+	clone.AddTail(11)
+	require.Equal(t, 5, d.Size(), "ArrayDeque clone is not a copy")
+	require.Equal(t, []int{2, 3, 4, 5, 10, 11}, Collect(clone.All()).Values(), "ArrayDeque collect is not matched")
+
+	d.Clear()
+	require.True(t, d.IsEmpty(), "ArrayDeque is not empty")
+}
+
+func TestArrayDeque_ModCount(t *testing.T) {
+	d := NewArrayDeque[int]()
+	d.AddAll([]int{3, 1, 2})
+	modCount := d.ModCount()
+	d.SetAt(0, 4)
+	require.Equal(t, modCount, d.ModCount(), "ArrayDeque mod count after set is not equal")
+	d.Reverse()
+	require.Greater(t, d.ModCount(), modCount, "ArrayDeque mod count after reverse is not increased")
+	modCount = d.ModCount()
+	d.Sort(func(a, b int) int { return a - b })
+	require.Greater(t, d.ModCount(), modCount, "ArrayDeque mod count after sort is not increased")
+}
+
+func TestArrayDeque_Iterator(t *testing.T) {
+	d := NewArrayDeque[int]()
+	d.AddAll([]int{2, 3, 4})
+	d.AddHead(1)
+
+	it := d.Iterator()
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "ArrayDeque iterator remove before next is not failed")
+	values := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		values = append(values, value)
+		if value%2 == 0 {
+			require.Nil(t, it.Remove(), "ArrayDeque iterator remove is failed")
+		}
+	}
+	require.Nil(t, it.Err(), "ArrayDeque iterator error is not nil")
+	require.Equal(t, []int{1, 2, 3, 4}, values, "ArrayDeque iterator values are not matched")
+	require.Equal(t, []int{1, 3}, d.Values(), "ArrayDeque values are not equal")
+
+	it = d.Iterator()
+	d.Reverse()
+	require.False(t, it.HasNext(), "ArrayDeque iterator has next after reverse is not matched")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayDeque iterator error is not matched")
+}
+
+func TestArrayDeque_ReverseIterator(t *testing.T) {
+	d := NewArrayDeque[int]()
+	d.AddAll([]int{2, 3, 4})
+	d.AddHead(1)
+
+	it := d.ReverseIterator()
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "ArrayDeque reverse iterator remove before next is not failed")
+	values := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		values = append(values, value)
+		if value%2 == 0 {
+			require.Nil(t, it.Remove(), "ArrayDeque reverse iterator remove is failed")
+		}
+	}
+	require.Nil(t, it.Err(), "ArrayDeque reverse iterator error is not nil")
+	require.Equal(t, []int{4, 3, 2, 1}, values, "ArrayDeque reverse iterator values are not matched")
+	require.Equal(t, []int{1, 3}, d.Values(), "ArrayDeque values are not equal")
+
+	it = d.ReverseIterator()
+	d.AddTail(5)
+	require.False(t, it.HasNext(), "ArrayDeque reverse iterator has next after add is not matched")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "ArrayDeque reverse iterator error is not matched")
+}
+
+func TestArrayDeque_GrowAndShrink(t *testing.T) {
+	d := NewArrayDequeWithCapacity[int](10)
+	require.Equal(t, 16, d.Capacity(), "ArrayDeque capacity is not rounded up")
+
+	for i := 0; i < 1000; i++ {
+		d.AddTail(i)
+	}
+	require.Equal(t, 1024, d.Capacity(), "ArrayDeque capacity is not grown")
+
+	for i := 0; i < 990; i++ {
+		value, _ := d.RemoveHead()
+		require.Equal(t, i, value, "ArrayDeque remove head is not matched")
+	}
+	require.Equal(t, 32, d.Capacity(), "ArrayDeque capacity is not shrunk")
+
+	for !d.IsEmpty() {
+		_, _ = d.RemoveTail()
+	}
+	require.Equal(t, 16, d.Capacity(), "ArrayDeque shrinks below its initial capacity")
+}
+
+func TestArrayDeque_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	d := NewArrayDeque[int]()
+	expected := []int{}
+
+	for i := 0; i < 20000; i++ {
+		switch random.Intn(7) {
+		case 0:
+			d.AddHead(i)
+			expected = slices.Insert(expected, 0, i)
+		case 1:
+			d.AddTail(i)
+			expected = append(expected, i)
+		case 2:
+			index := random.Intn(len(expected) + 1)
+			d.InsertAt(index, i)
+			expected = slices.Insert(expected, index, i)
+		case 3:
+			if value, err := d.RemoveHead(); err == nil {
+				require.Equal(t, expected[0], value, "ArrayDeque remove head is not matched")
+				expected = expected[1:]
+			}
+		case 4:
+			if value, err := d.RemoveTail(); err == nil {
+				require.Equal(t, expected[len(expected)-1], value, "ArrayDeque remove tail is not matched")
+				expected = expected[:len(expected)-1]
+			}
+		default:
+			if len(expected) > 0 {
+				index := random.Intn(len(expected))
+				value, err := d.RemoveAt(index)
+				require.Nil(t, err, "ArrayDeque remove at is failed")
+				require.Equal(t, expected[index], value, "ArrayDeque remove at is not matched")
+				expected = slices.Delete(expected, index, index+1)
+			}
+		}
+	}
+
+	require.Equal(t, len(expected), d.Size(), "ArrayDeque size is not equal")
+	require.Equal(t, expected, d.Values(), "ArrayDeque values are not equal")
+}
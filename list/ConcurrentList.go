@@ -2,9 +2,15 @@ package list
 
 import (
 	"go-utils/array"
+	"go-utils/collection"
 	"sync"
 )
 
+var (
+	_ collection.List[int]  = (*ConcurrentList[int])(nil)
+	_ collection.Deque[int] = (*ConcurrentList[int])(nil)
+)
+
 type ConcurrentList[T comparable] struct {
 	mu   sync.RWMutex
 	list *LinkedList[T]
@@ -15,10 +21,16 @@ func NewConcurrentList[T comparable]() *ConcurrentList[T] {
 }
 
 func (s *ConcurrentList[T]) Size() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return s.list.Size()
 }
 
 func (s *ConcurrentList[T]) IsEmpty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	return s.list.IsEmpty()
 }
 
@@ -36,6 +48,14 @@ func (s *ConcurrentList[T]) Values() []T {
 	return s.list.Values()
 }
 
+// Snapshot returns a copy of the values taken under the read lock.
+func (s *ConcurrentList[T]) Snapshot() []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Values()
+}
+
 func (s *ConcurrentList[T]) Add(value T) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -94,6 +114,13 @@ func (s *ConcurrentList[T]) GetAt(index int) (T, error) {
 	return s.list.GetAt(index)
 }
 
+func (s *ConcurrentList[T]) Get(index int) (T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.list.Get(index)
+}
+
 func (s *ConcurrentList[T]) RemoveHead() (T, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -131,13 +158,14 @@ func (s *ConcurrentList[T]) Clone() *ConcurrentList[T] {
 }
 
 func (s *ConcurrentList[T]) Merge(concurrentList *ConcurrentList[T]) {
+	// copy first so that the two locks are never held together; a.Merge(b)
+	// racing with b.Merge(a), or s.Merge(s), would otherwise deadlock
+	values := concurrentList.Values()
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	concurrentList.mu.RLock()
-	defer concurrentList.mu.RUnlock()
-
-	s.list.Merge(concurrentList.list)
+	s.list.AddAll(values)
 }
 
 func (s *ConcurrentList[T]) MergeArray(arr *array.Array[T]) {
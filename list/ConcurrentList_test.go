@@ -177,3 +177,52 @@ func validateConcurrentListTail(t *testing.T, list *ConcurrentList[int], expecte
 	require.Nil(t, err, "ConcurrentList getTail is failed")
 	require.Equal(t, expectedValue, value, "ConcurrentList tail item is not matched")
 }
+
+func TestConcurrentList_Stress(t *testing.T) {
+	list := NewConcurrentList[int]()
+	fixed := NewConcurrentList[int]()
+	fixed.AddAll([]int{1, 2})
+	left := NewConcurrentList[int]()
+	right := NewConcurrentList[int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				list.AddTail(w*500 + i)
+				_ = list.Size()
+				_ = list.IsEmpty()
+				_ = list.Contains(i)
+				_ = list.Values()
+				_, _ = list.GetHead()
+				if i%5 == 0 {
+					_, _ = list.RemoveHead()
+				}
+				if i%100 == 0 {
+					list.Merge(fixed)
+
+					// merging in both directions must not deadlock
+					if w%2 == 0 {
+						left.Merge(right)
+						left.Clear()
+					} else {
+						right.Merge(left)
+						right.Clear()
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 16*400+16*5*2, list.Size(), "ConcurrentList size is not equal")
+}
+
+func TestConcurrentList_MergeSelf(t *testing.T) {
+	list := NewConcurrentList[int]()
+	list.AddAll([]int{1, 2})
+	list.Merge(list)
+	require.Equal(t, []int{1, 2, 1, 2}, list.Values(), "ConcurrentList values are not equal")
+}
@@ -1,26 +1,26 @@
 package list
 
 import (
-	"errors"
 	"go-utils/array"
+	"go-utils/collection"
 )
 
-type doubleNode[T comparable] struct {
-	value T
-	prev  *doubleNode[T]
-	next  *doubleNode[T]
-}
+var (
+	_ collection.List[int]  = (*LinkedList[int])(nil)
+	_ collection.Deque[int] = (*LinkedList[int])(nil)
+)
 
 type LinkedList[T comparable] struct {
-	head *doubleNode[T]
-	tail *doubleNode[T]
-	size int
+	head     *Element[T]
+	tail     *Element[T]
+	size     int
+	modCount int
 }
 
 func NewLinkedList[T comparable]() *LinkedList[T] {
 	var zero T
-	head := &doubleNode[T]{value: zero}
-	tail := &doubleNode[T]{value: zero}
+	head := &Element[T]{value: zero}
+	tail := &Element[T]{value: zero}
 	head.next = tail
 	tail.prev = head
 	return &LinkedList[T]{
@@ -39,6 +39,12 @@ func (s *LinkedList[T]) IsEmpty() bool {
 }
 
 func (s *LinkedList[T]) Clear() {
+	s.modCount++
+
+	// orphan the elements so that handles to them are no longer accepted
+	for node := s.head.next; node != s.tail; node = node.next {
+		node.list = nil
+	}
 	s.head.next = s.tail
 	s.tail.prev = s.head
 	s.size = 0
@@ -69,12 +75,12 @@ func (s *LinkedList[T]) AddAll(values []T) {
 }
 
 func (s *LinkedList[T]) AddHead(value T) {
-	newNode := &doubleNode[T]{value: value, prev: s.head, next: s.head.next}
+	newNode := &Element[T]{value: value, prev: s.head, next: s.head.next, list: s}
 	s.attachHeadNode(newNode)
 }
 
 func (s *LinkedList[T]) AddTail(value T) {
-	newNode := &doubleNode[T]{value: value, prev: s.tail.prev, next: s.tail}
+	newNode := &Element[T]{value: value, prev: s.tail.prev, next: s.tail, list: s}
 	s.attachTailNode(newNode)
 }
 
@@ -89,33 +95,40 @@ func (s *LinkedList[T]) InsertAt(index int, value T) {
 			current = current.next
 		}
 
-		newNode := &doubleNode[T]{value: value, prev: current.prev, next: current}
-		current.prev.next = newNode
-		current.prev = newNode
-		s.size++
+		s.insertBefore(value, current)
 	}
 }
 
 func (s *LinkedList[T]) GetHead() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("List is empty")
+		return zero, collection.ErrEmpty
 	}
 	return s.head.next.value, nil
 }
 
+// MustGetHead is like GetHead but panics on error.
+func (s *LinkedList[T]) MustGetHead() T {
+	return collection.Must(s.GetHead())
+}
+
 func (s *LinkedList[T]) GetTail() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("List is empty")
+		return zero, collection.ErrEmpty
 	}
 	return s.tail.prev.value, nil
 }
 
+// MustGetTail is like GetTail but panics on error.
+func (s *LinkedList[T]) MustGetTail() T {
+	return collection.Must(s.GetTail())
+}
+
 func (s *LinkedList[T]) GetAt(index int) (T, error) {
 	if s.IsEmpty() || index < 0 || index >= s.size {
 		var zero T
-		return zero, errors.New("Index out of range")
+		return zero, &collection.IndexError{Index: index, Size: s.size}
 	}
 
 	current := s.head.next
@@ -125,10 +138,19 @@ func (s *LinkedList[T]) GetAt(index int) (T, error) {
 	return current.value, nil
 }
 
+func (s *LinkedList[T]) Get(index int) (T, error) {
+	return s.GetAt(index)
+}
+
+// MustGet is like Get but panics on error.
+func (s *LinkedList[T]) MustGet(index int) T {
+	return collection.Must(s.Get(index))
+}
+
 func (s *LinkedList[T]) RemoveHead() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("List is empty")
+		return zero, collection.ErrEmpty
 	}
 
 	return s.detachHeadNode().value, nil
@@ -137,7 +159,7 @@ func (s *LinkedList[T]) RemoveHead() (T, error) {
 func (s *LinkedList[T]) RemoveTail() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("List is empty")
+		return zero, collection.ErrEmpty
 	}
 
 	return s.detachTailNode().value, nil
@@ -146,18 +168,15 @@ func (s *LinkedList[T]) RemoveTail() (T, error) {
 func (s *LinkedList[T]) RemoveAt(index int) (T, error) {
 	if s.IsEmpty() || index < 0 || index >= s.size {
 		var zero T
-		return zero, errors.New("Index out of range")
+		return zero, &collection.IndexError{Index: index, Size: s.size}
 	}
 
 	current := s.head.next
 	for i := 0; i < index; i++ {
 		current = current.next
 	}
-	value := current.value
-	current.prev.next = current.next
-	current.next.prev = current.prev
-	s.size--
-	return value, nil
+	s.detachNode(current)
+	return current.value, nil
 }
 
 func (s *LinkedList[T]) Contains(value T) bool {
@@ -171,6 +190,13 @@ func (s *LinkedList[T]) Contains(value T) bool {
 	return false
 }
 
+// ModCount returns how many times the links of the list have changed: values
+// added or removed, or elements reordered. Iterators compare it with the count
+// they started from to detect modifications.
+func (s *LinkedList[T]) ModCount() int {
+	return s.modCount
+}
+
 func (s *LinkedList[T]) Clone() *LinkedList[T] {
 	list := NewLinkedList[T]()
 
@@ -209,7 +235,8 @@ func (s *LinkedList[T]) Reverse() {
 		return
 	}
 
-	var temp *doubleNode[T]
+	s.modCount++
+	var temp *Element[T]
 	node := s.head.next
 	current := s.head.next
 	for current.next != nil {
@@ -234,6 +261,7 @@ func (s *LinkedList[T]) Sort(comparator func(T, T) int) {
 		return
 	}
 
+	s.modCount++
 	s.tail.prev.next = nil
 	s.head.next = mergeSortList(s.head.next, comparator)
 
@@ -250,35 +278,53 @@ func (s *LinkedList[T]) Sort(comparator func(T, T) int) {
 	s.tail.prev = prev
 }
 
-func (s *LinkedList[T]) attachHeadNode(node *doubleNode[T]) {
+func (s *LinkedList[T]) attachHeadNode(node *Element[T]) {
+	s.modCount++
 	s.head.next.prev = node
 	s.head.next = node
 	s.size++
 }
 
-func (s *LinkedList[T]) attachTailNode(node *doubleNode[T]) {
+func (s *LinkedList[T]) attachTailNode(node *Element[T]) {
+	s.modCount++
 	s.tail.prev.next = node
 	s.tail.prev = node
 	s.size++
 }
 
-func (s *LinkedList[T]) detachHeadNode() *doubleNode[T] {
+func (s *LinkedList[T]) detachHeadNode() *Element[T] {
 	node := s.head.next
-	s.head.next = s.head.next.next
-	s.head.next.prev = s.head
-	s.size--
+	s.detachNode(node)
 	return node
 }
 
-func (s *LinkedList[T]) detachTailNode() *doubleNode[T] {
+func (s *LinkedList[T]) detachTailNode() *Element[T] {
 	node := s.tail.prev
-	s.tail.prev = s.tail.prev.prev
-	s.tail.prev.next = s.tail
-	s.size--
+	s.detachNode(node)
+	return node
+}
+
+func (s *LinkedList[T]) insertBefore(value T, mark *Element[T]) *Element[T] {
+	s.modCount++
+	node := &Element[T]{value: value, prev: mark.prev, next: mark, list: s}
+	mark.prev.next = node
+	mark.prev = node
+	s.size++
 	return node
 }
 
-func mergeSortList[T comparable](head *doubleNode[T], comparator func(T, T) int) *doubleNode[T] {
+// detachNode unlinks the node and orphans it.
+func (s *LinkedList[T]) detachNode(node *Element[T]) {
+	s.modCount++
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev = nil
+	node.next = nil
+	node.list = nil
+	s.size--
+}
+
+func mergeSortList[T comparable](head *Element[T], comparator func(T, T) int) *Element[T] {
 	if head == nil || head.next == nil {
 		return head
 	}
@@ -289,7 +335,7 @@ func mergeSortList[T comparable](head *doubleNode[T], comparator func(T, T) int)
 	return mergeList(left, right, comparator)
 }
 
-func splitList[T comparable](head *doubleNode[T]) (*doubleNode[T], *doubleNode[T]) {
+func splitList[T comparable](head *Element[T]) (*Element[T], *Element[T]) {
 	if head == nil || head.next == nil {
 		return head, nil
 	}
@@ -305,7 +351,7 @@ func splitList[T comparable](head *doubleNode[T]) (*doubleNode[T], *doubleNode[T
 	return head, mid
 }
 
-func mergeList[T comparable](left, right *doubleNode[T], comparator func(T, T) int) *doubleNode[T] {
+func mergeList[T comparable](left, right *Element[T], comparator func(T, T) int) *Element[T] {
 	if left == nil {
 		return right
 	}
@@ -313,7 +359,7 @@ func mergeList[T comparable](left, right *doubleNode[T], comparator func(T, T) i
 		return left
 	}
 
-	var head *doubleNode[T]
+	var head *Element[T]
 	if comparator(left.value, right.value) <= 0 {
 		head = left
 		head.next = mergeList(left.next, right, comparator)
@@ -0,0 +1,188 @@
+package list
+
+import (
+	"go-utils/collection"
+)
+
+var (
+	_ collection.Iterator[int] = (*ListIterator[int])(nil)
+	_ collection.Iterator[int] = (*ReverseIterator[int])(nil)
+)
+
+// ListIterator walks a LinkedList in both directions, like Java's
+// ListIterator. Its cursor sits between two values: Next returns the value
+// after it and Previous the value before it. Set, Remove and Add work on the
+// value last returned by Next or Previous in O(1), and only Remove and Add
+// keep the iterator valid when they change the list.
+type ListIterator[T comparable] struct {
+	list     *LinkedList[T]
+	next     *Element[T]
+	index    int
+	last     *Element[T]
+	modCount int
+	err      error
+}
+
+// ListIterator returns an iterator positioned before the first value.
+func (s *LinkedList[T]) ListIterator() *ListIterator[T] {
+	return &ListIterator[T]{list: s, next: s.head.next, modCount: s.modCount}
+}
+
+// ListIteratorAt returns an iterator whose first call to Next returns the
+// value at index, walking from the nearer end. An index equal to the size
+// positions it after the last value.
+func (s *LinkedList[T]) ListIteratorAt(index int) (*ListIterator[T], error) {
+	if index < 0 || index > s.size {
+		return nil, &collection.IndexError{Index: index, Size: s.size}
+	}
+
+	next := s.tail
+	if index < s.size/2 {
+		next = s.head.next
+		for i := 0; i < index; i++ {
+			next = next.next
+		}
+	} else {
+		for i := s.size; i > index; i-- {
+			next = next.prev
+		}
+	}
+	return &ListIterator[T]{list: s, next: next, index: index, modCount: s.modCount}, nil
+}
+
+func (it *ListIterator[T]) HasNext() bool {
+	return it.check() == nil && it.next != it.list.tail
+}
+
+// Next returns the value after the cursor and moves the cursor past it, or
+// returns the zero value if there is none or the list was modified.
+func (it *ListIterator[T]) Next() T {
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
+	it.last = it.next
+	it.next = it.next.next
+	it.index++
+	return it.last.value
+}
+
+func (it *ListIterator[T]) HasPrevious() bool {
+	return it.check() == nil && it.next.prev != it.list.head
+}
+
+// Previous returns the value before the cursor and moves the cursor back
+// over it, or returns the zero value if there is none or the list was
+// modified.
+func (it *ListIterator[T]) Previous() T {
+	if !it.HasPrevious() {
+		var zero T
+		return zero
+	}
+
+	it.next = it.next.prev
+	it.last = it.next
+	it.index--
+	return it.last.value
+}
+
+// NextIndex returns the index of the value Next would return, or the size of
+// the list at the end.
+func (it *ListIterator[T]) NextIndex() int {
+	return it.index
+}
+
+// PreviousIndex returns the index of the value Previous would return, or -1
+// at the start.
+func (it *ListIterator[T]) PreviousIndex() int {
+	return it.index - 1
+}
+
+// Set replaces the value last returned by Next or Previous.
+func (it *ListIterator[T]) Set(value T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last == nil {
+		return collection.ErrIllegalState
+	}
+
+	it.last.value = value
+	return nil
+}
+
+// Remove removes the value last returned by Next or Previous.
+func (it *ListIterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last == nil {
+		return collection.ErrIllegalState
+	}
+
+	if it.last == it.next {
+		// returned by Previous: the cursor stays before the removed value
+		it.next = it.last.next
+	} else {
+		it.index--
+	}
+	it.list.detachNode(it.last)
+	it.last = nil
+	it.modCount = it.list.modCount
+	return nil
+}
+
+// Add inserts the value at the cursor, so that Previous would return it and
+// Next is unaffected.
+func (it *ListIterator[T]) Add(value T) error {
+	if err := it.check(); err != nil {
+		return err
+	}
+
+	it.list.insertBefore(value, it.next)
+	it.index++
+	it.last = nil
+	it.modCount = it.list.modCount
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the list.
+func (it *ListIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *ListIterator[T]) check() error {
+	if it.err == nil && it.modCount != it.list.modCount {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
+}
+
+// ReverseIterator walks a LinkedList from the tail to the head. It fails fast
+// like Iterator.
+type ReverseIterator[T comparable] struct {
+	it *ListIterator[T]
+}
+
+func (s *LinkedList[T]) ReverseIterator() *ReverseIterator[T] {
+	return &ReverseIterator[T]{&ListIterator[T]{list: s, next: s.tail, index: s.size, modCount: s.modCount}}
+}
+
+func (it *ReverseIterator[T]) HasNext() bool {
+	return it.it.HasPrevious()
+}
+
+func (it *ReverseIterator[T]) Next() T {
+	return it.it.Previous()
+}
+
+// Remove removes the value last returned by Next.
+func (it *ReverseIterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+func (it *ReverseIterator[T]) Err() error {
+	return it.it.Err()
+}
@@ -1,6 +1,8 @@
 package list
 
 import (
+	"go-utils/collection"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -193,3 +195,142 @@ func validateDLinkedListRemoveAt(t *testing.T, list *LinkedList[int], index, exp
 	require.Nil(t, err, "LinkedList removeAt is failed")
 	require.Equal(t, expectedValue, value, "LinkedList removeAt item is not matched")
 }
+
+func TestLinkedList_All(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, 2, 3, 4})
+
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(list.All()), "LinkedList all is failed")
+	require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(list.Backward()), "LinkedList backward is failed")
+
+	for i, value := range list.Enumerate() {
+		expected, _ := list.GetAt(i)
+		require.Equal(t, expected, value, "LinkedList enumerate item is not matched")
+	}
+
+	// stop early
+	visited := []int{}
+	for value := range list.Backward() {
+		if value == 2 {
+			break
+		}
+		visited = append(visited, value)
+	}
+	require.Equal(t, []int{4, 3}, visited, "LinkedList backward break is failed")
+
+	collected := Collect(list.Backward())
+	require.Equal(t, []int{4, 3, 2, 1}, collected.Values(), "LinkedList collect is failed")
+	require.Empty(t, slices.Collect(NewLinkedList[int]().All()), "LinkedList all is not empty")
+}
+
+func TestLinkedList_IteratorFailFast(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, 2, 3})
+
+	it := list.Iterator()
+	require.Equal(t, 1, it.Next(), "LinkedList iterator next is not equal")
+	list.AddHead(0)
+	require.False(t, it.HasNext(), "LinkedList iterator has next after modification is not matched")
+	require.Equal(t, 0, it.Next(), "LinkedList iterator next after modification is not equal")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "LinkedList iterator error is not matched")
+
+	it = list.Iterator()
+	it.Next()
+	list.MoveToBack(list.Front())
+	require.ErrorIs(t, it.Remove(), collection.ErrConcurrentModification, "LinkedList iterator remove after a move is not failed")
+}
+
+func TestLinkedList_IteratorRemove(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, 2, 3, 4, 5, 6})
+
+	it := list.Iterator()
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "LinkedList iterator remove before next is not failed")
+	visited := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		visited = append(visited, value)
+		if value%2 == 1 {
+			require.Nil(t, it.Remove(), "LinkedList iterator remove is failed")
+			require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "LinkedList iterator remove twice is not failed")
+		}
+	}
+	require.Nil(t, it.Err(), "LinkedList iterator error is not nil")
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, visited, "LinkedList iterator values are not matched")
+	checkLinks(t, list, []int{2, 4, 6})
+}
+
+func TestLinkedList_ListIterator(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, 2, 3})
+
+	it := list.ListIterator()
+	require.False(t, it.HasPrevious(), "LinkedList list iterator has previous at start is not matched")
+	require.Equal(t, -1, it.PreviousIndex(), "LinkedList list iterator previous index is not equal")
+	require.ErrorIs(t, it.Set(0), collection.ErrIllegalState, "LinkedList list iterator set before next is not failed")
+
+	require.Equal(t, 1, it.Next(), "LinkedList list iterator next is not equal")
+	require.Equal(t, 2, it.Next(), "LinkedList list iterator next is not equal")
+	require.Equal(t, 2, it.NextIndex(), "LinkedList list iterator next index is not equal")
+	require.Equal(t, 2, it.Previous(), "LinkedList list iterator previous is not equal")
+	require.Equal(t, 1, it.NextIndex(), "LinkedList list iterator next index is not equal")
+
+	require.Nil(t, it.Set(20), "LinkedList list iterator set is failed")
+	require.Nil(t, it.Remove(), "LinkedList list iterator remove after previous is failed")
+	require.ErrorIs(t, it.Set(0), collection.ErrIllegalState, "LinkedList list iterator set after remove is not failed")
+	require.Equal(t, []int{1, 3}, list.Values(), "LinkedList values are not equal")
+	require.Equal(t, 1, it.NextIndex(), "LinkedList list iterator next index is not equal")
+
+	require.Nil(t, it.Add(4), "LinkedList list iterator add is failed")
+	require.Nil(t, it.Add(5), "LinkedList list iterator add is failed")
+	require.Equal(t, []int{1, 4, 5, 3}, list.Values(), "LinkedList values are not equal")
+	require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "LinkedList list iterator remove after add is not failed")
+	require.Equal(t, 3, it.Next(), "LinkedList list iterator next after add is not equal")
+	require.Nil(t, it.Remove(), "LinkedList list iterator remove after next is failed")
+	require.Equal(t, 3, it.NextIndex(), "LinkedList list iterator next index is not equal")
+	require.False(t, it.HasNext(), "LinkedList list iterator has next at end is not matched")
+
+	values := []int{}
+	for it.HasPrevious() {
+		values = append(values, it.Previous())
+	}
+	require.Equal(t, []int{5, 4, 1}, values, "LinkedList list iterator backward is not matched")
+
+	list.Add(6)
+	require.False(t, it.HasNext(), "LinkedList list iterator has next after modification is not matched")
+	require.ErrorIs(t, it.Add(7), collection.ErrConcurrentModification, "LinkedList list iterator add after modification is not failed")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "LinkedList list iterator error is not matched")
+
+	it, err := list.ListIteratorAt(2)
+	require.Nil(t, err, "LinkedList list iterator at is failed")
+	require.Equal(t, 5, it.Next(), "LinkedList list iterator at next is not equal")
+	it, err = list.ListIteratorAt(list.Size())
+	require.Nil(t, err, "LinkedList list iterator at size is failed")
+	require.Equal(t, 6, it.Previous(), "LinkedList list iterator at size previous is not equal")
+	_, err = list.ListIteratorAt(list.Size() + 1)
+	require.NotNil(t, err, "LinkedList list iterator out of range is not failed")
+}
+
+func TestLinkedList_ReverseIterator(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, 2, 3, 4})
+
+	it := list.ReverseIterator()
+	values := []int{}
+	for it.HasNext() {
+		value := it.Next()
+		values = append(values, value)
+		if value%2 == 0 {
+			require.Nil(t, it.Remove(), "LinkedList reverse iterator remove is failed")
+		}
+	}
+	require.Nil(t, it.Err(), "LinkedList reverse iterator error is not nil")
+	require.Equal(t, []int{4, 3, 2, 1}, values, "LinkedList reverse iterator values are not matched")
+	require.Equal(t, []int{1, 3}, list.Values(), "LinkedList values are not equal")
+
+	it = list.ReverseIterator()
+	it.Next()
+	list.RemoveAt(0)
+	require.False(t, it.HasNext(), "LinkedList reverse iterator has next after modification is not matched")
+	require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "LinkedList reverse iterator error is not matched")
+}
@@ -0,0 +1,138 @@
+package list
+
+// Cursor walks a LinkedList in both directions and can replace or remove the
+// element under it, or insert next to it, in O(1).
+//
+// After Remove the cursor is not on any element, but Next and Prev still move
+// to the neighbours of the removed one, so a loop can remove as it goes:
+//
+//	c := l.Cursor()
+//	for ok := c.First(); ok; ok = c.Next() {
+//		if c.Value() < 0 {
+//			c.Remove()
+//		}
+//	}
+//
+// Modifying the list other than through the cursor invalidates it; call
+// First or Last before using it again.
+type Cursor[T comparable] struct {
+	list *LinkedList[T]
+	node *Element[T]
+	prev *Element[T]
+	next *Element[T]
+}
+
+// Cursor returns an unpositioned cursor over the list.
+func (s *LinkedList[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: s}
+}
+
+// Valid reports whether the cursor is positioned on an element.
+func (c *Cursor[T]) Valid() bool {
+	return c.node != nil
+}
+
+// Value returns the value under the cursor, or the zero value if the cursor
+// is not valid.
+func (c *Cursor[T]) Value() T {
+	if !c.Valid() {
+		var zero T
+		return zero
+	}
+	return c.node.value
+}
+
+// Element returns the element under the cursor, or nil.
+func (c *Cursor[T]) Element() *Element[T] {
+	return c.node
+}
+
+// First moves the cursor to the first element.
+func (c *Cursor[T]) First() bool {
+	return c.moveTo(c.list.head.next)
+}
+
+// Last moves the cursor to the last element.
+func (c *Cursor[T]) Last() bool {
+	return c.moveTo(c.list.tail.prev)
+}
+
+// Next moves the cursor to the following element. It returns false, leaving
+// the cursor invalid, when there is none.
+func (c *Cursor[T]) Next() bool {
+	switch {
+	case c.node != nil:
+		return c.moveTo(c.node.next)
+	case c.next != nil:
+		return c.moveTo(c.next)
+	default:
+		return false
+	}
+}
+
+// Prev moves the cursor to the preceding element. It returns false, leaving
+// the cursor invalid, when there is none.
+func (c *Cursor[T]) Prev() bool {
+	switch {
+	case c.node != nil:
+		return c.moveTo(c.node.prev)
+	case c.prev != nil:
+		return c.moveTo(c.prev)
+	default:
+		return false
+	}
+}
+
+// Set replaces the value under the cursor and reports whether the cursor is valid.
+func (c *Cursor[T]) Set(value T) bool {
+	if !c.Valid() {
+		return false
+	}
+
+	c.node.value = value
+	return true
+}
+
+// Remove removes the element under the cursor and reports whether the cursor
+// was valid.
+func (c *Cursor[T]) Remove() bool {
+	if !c.Valid() {
+		return false
+	}
+
+	node := c.node
+	c.node, c.prev, c.next = nil, node.prev, node.next
+	c.list.detachNode(node)
+	return true
+}
+
+// InsertBefore adds the value before the element under the cursor, which
+// stays where it is, and returns the new element or nil if the cursor is not
+// valid.
+func (c *Cursor[T]) InsertBefore(value T) *Element[T] {
+	if !c.Valid() {
+		return nil
+	}
+	return c.list.insertBefore(value, c.node)
+}
+
+// InsertAfter adds the value after the element under the cursor, which stays
+// where it is, and returns the new element or nil if the cursor is not valid.
+func (c *Cursor[T]) InsertAfter(value T) *Element[T] {
+	if !c.Valid() {
+		return nil
+	}
+	return c.list.insertBefore(value, c.node.next)
+}
+
+// moveTo positions the cursor on node, or leaves it invalid at a sentinel.
+func (c *Cursor[T]) moveTo(node *Element[T]) bool {
+	c.prev, c.next = nil, nil
+	if node == c.list.head || node == c.list.tail {
+		c.node = nil
+		return false
+	}
+
+	c.node = node
+	return true
+}
@@ -0,0 +1,152 @@
+package list
+
+// Element is a node of a LinkedList. The Element methods of the list take one
+// as a handle to insert next to it, move it or remove it in O(1), instead of
+// walking to an index. An element that has been removed, or whose list was
+// cleared, no longer belongs to any list and is rejected by those methods.
+type Element[T comparable] struct {
+	value T
+	prev  *Element[T]
+	next  *Element[T]
+	list  *LinkedList[T]
+}
+
+func (e *Element[T]) Value() T {
+	return e.value
+}
+
+func (e *Element[T]) SetValue(value T) {
+	e.value = value
+}
+
+// Next returns the following element, or nil at the end of the list.
+func (e *Element[T]) Next() *Element[T] {
+	if e.list == nil || e.next == e.list.tail {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the preceding element, or nil at the start of the list.
+func (e *Element[T]) Prev() *Element[T] {
+	if e.list == nil || e.prev == e.list.head {
+		return nil
+	}
+	return e.prev
+}
+
+// Front returns the first element, or nil if the list is empty.
+func (s *LinkedList[T]) Front() *Element[T] {
+	if s.IsEmpty() {
+		return nil
+	}
+	return s.head.next
+}
+
+// Back returns the last element, or nil if the list is empty.
+func (s *LinkedList[T]) Back() *Element[T] {
+	if s.IsEmpty() {
+		return nil
+	}
+	return s.tail.prev
+}
+
+// PushFrontElement adds the value at the head and returns its element.
+func (s *LinkedList[T]) PushFrontElement(value T) *Element[T] {
+	return s.insertBefore(value, s.head.next)
+}
+
+// PushBackElement adds the value at the tail and returns its element.
+func (s *LinkedList[T]) PushBackElement(value T) *Element[T] {
+	return s.insertBefore(value, s.tail)
+}
+
+// InsertBefore adds the value right before mark and returns its element, or
+// nil if mark is not in the list.
+func (s *LinkedList[T]) InsertBefore(value T, mark *Element[T]) *Element[T] {
+	if !s.owns(mark) {
+		return nil
+	}
+	return s.insertBefore(value, mark)
+}
+
+// InsertAfter adds the value right after mark and returns its element, or nil
+// if mark is not in the list.
+func (s *LinkedList[T]) InsertAfter(value T, mark *Element[T]) *Element[T] {
+	if !s.owns(mark) {
+		return nil
+	}
+	return s.insertBefore(value, mark.next)
+}
+
+// Remove removes the element and reports whether it was in the list.
+func (s *LinkedList[T]) Remove(e *Element[T]) bool {
+	if !s.owns(e) {
+		return false
+	}
+
+	s.detachNode(e)
+	return true
+}
+
+// MoveToFront moves the element to the head and reports whether it is in the list.
+func (s *LinkedList[T]) MoveToFront(e *Element[T]) bool {
+	if !s.owns(e) {
+		return false
+	}
+
+	s.move(e, s.head.next)
+	return true
+}
+
+// MoveToBack moves the element to the tail and reports whether it is in the list.
+func (s *LinkedList[T]) MoveToBack(e *Element[T]) bool {
+	if !s.owns(e) {
+		return false
+	}
+
+	s.move(e, s.tail)
+	return true
+}
+
+// MoveBefore moves the element right before mark. It reports whether both are
+// in the list; moving an element next to itself changes nothing.
+func (s *LinkedList[T]) MoveBefore(e, mark *Element[T]) bool {
+	if !s.owns(e) || !s.owns(mark) {
+		return false
+	}
+
+	s.move(e, mark)
+	return true
+}
+
+// MoveAfter moves the element right after mark. It reports whether both are
+// in the list; moving an element next to itself changes nothing.
+func (s *LinkedList[T]) MoveAfter(e, mark *Element[T]) bool {
+	if !s.owns(e) || !s.owns(mark) {
+		return false
+	}
+
+	s.move(e, mark.next)
+	return true
+}
+
+func (s *LinkedList[T]) owns(e *Element[T]) bool {
+	return e != nil && e.list == s
+}
+
+// move relinks the element right before next, which may be the tail sentinel.
+func (s *LinkedList[T]) move(e, next *Element[T]) {
+	if e == next || e.next == next {
+		return
+	}
+
+	s.modCount++
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = next.prev
+	e.next = next
+	next.prev.next = e
+	next.prev = e
+}
@@ -0,0 +1,122 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// checkLinks verifies the list in both directions against the expected values.
+func checkLinks(t *testing.T, list *LinkedList[int], expected []int) {
+	require.Equal(t, expected, list.Values(), "LinkedList values are not equal")
+	require.Equal(t, len(expected), list.Size(), "LinkedList size is not equal")
+
+	backward := []int{}
+	for e := list.Back(); e != nil; e = e.Prev() {
+		backward = append(backward, e.Value())
+	}
+	for i, j := 0, len(backward)-1; i < j; i, j = i+1, j-1 {
+		backward[i], backward[j] = backward[j], backward[i]
+	}
+	require.Equal(t, expected, backward, "LinkedList backward links are not matched")
+}
+
+func TestLinkedList_Element(t *testing.T) {
+	list := NewLinkedList[int]()
+	require.Nil(t, list.Front(), "LinkedList front of empty is not nil")
+	require.Nil(t, list.Back(), "LinkedList back of empty is not nil")
+
+	two := list.PushBackElement(2)
+	four := list.PushBackElement(4)
+	one := list.PushFrontElement(1)
+	three := list.InsertAfter(3, two)
+	five := list.InsertBefore(5, list.Back().Next())
+	require.Nil(t, five, "LinkedList insert before nil is not nil")
+	five = list.InsertAfter(5, four)
+	checkLinks(t, list, []int{1, 2, 3, 4, 5})
+	require.Equal(t, one, list.Front(), "LinkedList front is not matched")
+	require.Equal(t, three, two.Next(), "LinkedList next is not matched")
+	require.Nil(t, five.Next(), "LinkedList next of back is not nil")
+	require.Nil(t, one.Prev(), "LinkedList prev of front is not nil")
+
+	require.True(t, list.MoveToFront(four), "LinkedList move to front is not matched")
+	checkLinks(t, list, []int{4, 1, 2, 3, 5})
+	require.True(t, list.MoveToBack(one), "LinkedList move to back is not matched")
+	checkLinks(t, list, []int{4, 2, 3, 5, 1})
+	require.True(t, list.MoveBefore(five, two), "LinkedList move before is not matched")
+	checkLinks(t, list, []int{4, 5, 2, 3, 1})
+	require.True(t, list.MoveAfter(four, three), "LinkedList move after is not matched")
+	checkLinks(t, list, []int{5, 2, 3, 4, 1})
+	require.True(t, list.MoveAfter(four, four), "LinkedList move after itself is not matched")
+	require.True(t, list.MoveBefore(four, one), "LinkedList move to its own place is not matched")
+	checkLinks(t, list, []int{5, 2, 3, 4, 1})
+
+	three.SetValue(30)
+	require.True(t, list.Remove(three), "LinkedList remove is not matched")
+	require.False(t, list.Remove(three), "LinkedList remove twice is not matched")
+	require.Nil(t, list.InsertAfter(6, three), "LinkedList insert after a removed element is not nil")
+	require.False(t, list.MoveToFront(three), "LinkedList move of a removed element is not matched")
+	require.Equal(t, 30, three.Value(), "LinkedList removed element value is not equal")
+	checkLinks(t, list, []int{5, 2, 4, 1})
+
+	other := NewLinkedList[int]()
+	foreign := other.PushBackElement(9)
+	require.False(t, list.Remove(foreign), "LinkedList remove of a foreign element is not matched")
+	require.False(t, list.MoveBefore(two, foreign), "LinkedList move before a foreign element is not matched")
+
+	value, _ := list.RemoveHead()
+	require.Equal(t, 5, value, "LinkedList remove head is not equal")
+	require.False(t, list.Remove(five), "LinkedList remove of a polled element is not matched")
+
+	list.Sort(func(a, b int) int { return a - b })
+	checkLinks(t, list, []int{1, 2, 4})
+	require.True(t, list.MoveToBack(two), "LinkedList element after sort is not matched")
+	checkLinks(t, list, []int{1, 4, 2})
+
+	list.Clear()
+	require.False(t, list.Remove(one), "LinkedList remove after clear is not matched")
+	checkLinks(t, list, []int{})
+}
+
+func TestLinkedList_Cursor(t *testing.T) {
+	list := NewLinkedList[int]()
+	list.AddAll([]int{1, -2, 3, -4, -5, 6})
+
+	c := list.Cursor()
+	require.False(t, c.Valid(), "LinkedList cursor is not unpositioned")
+	require.False(t, c.Next(), "LinkedList unpositioned cursor next is not matched")
+	require.False(t, c.Set(0), "LinkedList unpositioned cursor set is not matched")
+
+	for ok := c.First(); ok; ok = c.Next() {
+		if c.Value() < 0 {
+			require.True(t, c.Remove(), "LinkedList cursor remove is not matched")
+			require.False(t, c.Remove(), "LinkedList cursor remove twice is not matched")
+		} else {
+			c.Set(c.Value() * 10)
+		}
+	}
+	require.False(t, c.Valid(), "LinkedList cursor past the end is not invalid")
+	checkLinks(t, list, []int{10, 30, 60})
+
+	require.True(t, c.Last(), "LinkedList cursor last is not matched")
+	require.Equal(t, 60, c.Element().Value(), "LinkedList cursor element is not matched")
+	c.InsertBefore(50)
+	c.InsertAfter(70)
+	checkLinks(t, list, []int{10, 30, 50, 60, 70})
+
+	values := []int{}
+	for ok := c.Last(); ok; ok = c.Prev() {
+		values = append(values, c.Value())
+		if c.Value() == 50 {
+			c.Remove()
+		}
+	}
+	require.Equal(t, []int{70, 60, 50, 30, 10}, values, "LinkedList cursor backward is not matched")
+	checkLinks(t, list, []int{10, 30, 60, 70})
+
+	require.True(t, c.First(), "LinkedList cursor first is not matched")
+	c.Remove()
+	require.False(t, c.Prev(), "LinkedList cursor prev after removing the head is not matched")
+	require.True(t, c.First(), "LinkedList cursor first is not matched")
+	require.Equal(t, 30, c.Value(), "LinkedList cursor value is not equal")
+}
@@ -1,22 +1,109 @@
 package list
 
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+// Iterator walks a LinkedList from the head. It fails fast: once the list is
+// modified other than through Remove, HasNext returns false and Err reports
+// collection.ErrConcurrentModification.
 type Iterator[T comparable] struct {
-	node *doubleNode[T]
-	end  *doubleNode[T]
+	list     *LinkedList[T]
+	node     *Element[T]
+	last     *Element[T]
+	modCount int
+	err      error
 }
 
 func (s *LinkedList[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{node: s.head.next, end: s.tail}
+	return &Iterator[T]{list: s, node: s.head.next, modCount: s.modCount}
+}
+
+func (s *LinkedList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.head.next; node != s.tail; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LinkedList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := s.tail.prev; node != s.head; node = node.prev {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LinkedList[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for node := s.head.next; node != s.tail; node = node.next {
+			if !yield(index, node.value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func Collect[T comparable](seq iter.Seq[T]) *LinkedList[T] {
+	result := NewLinkedList[T]()
+	for value := range seq {
+		result.AddTail(value)
+	}
+	return result
 }
 
 func (it *Iterator[T]) HasNext() bool {
-	return it.node != it.end
+	return it.check() == nil && it.node != it.list.tail
 }
 
+// Next returns the next value, or the zero value if there is none or the list
+// was modified.
 func (it *Iterator[T]) Next() T {
-	value := it.node.value
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
+	it.last = it.node
 	it.node = it.node.next
-	return value
+	return it.last.value
+}
+
+// Remove removes the value last returned by Next. It is the only way to
+// remove values while iterating without failing the iterator.
+func (it *Iterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+	if it.last == nil {
+		return collection.ErrIllegalState
+	}
+
+	it.list.detachNode(it.last)
+	it.last = nil
+	it.modCount = it.list.modCount
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the list.
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+func (it *Iterator[T]) check() error {
+	if it.err == nil && it.modCount != it.list.modCount {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
 }
 
 func (it *Iterator[T]) Each(action func(T)) {
@@ -25,6 +112,10 @@ func (it *Iterator[T]) Each(action func(T)) {
 	}
 }
 
+// Map builds a LinkedList of the mapped values.
+//
+// Deprecated: use stream.Map, which maps lazily instead of building a LinkedList
+// of every result first.
 func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *LinkedList[V] {
 	result := NewLinkedList[V]()
 	for it.HasNext() {
@@ -33,6 +124,9 @@ func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *LinkedL
 	return result
 }
 
+// Reduce folds the values into a single result.
+//
+// Deprecated: use stream.Reduce.
 func Reduce[T comparable, V any](it *Iterator[T], initial V, reducer func(V, T) V) V {
 	acc := initial
 	for it.HasNext() {
@@ -41,6 +135,10 @@ func Reduce[T comparable, V any](it *Iterator[T], initial V, reducer func(V, T)
 	return acc
 }
 
+// Filter builds a LinkedList of the values that match the predicate.
+//
+// Deprecated: use Stream.Filter, which skips values lazily instead of copying
+// the matches into a new LinkedList.
 func Filter[T comparable](it *Iterator[T], predicate func(T) bool) *LinkedList[T] {
 	filtered := NewLinkedList[T]()
 	for it.HasNext() {
@@ -0,0 +1,180 @@
+package maps
+
+import (
+	"go-utils/collection"
+	"hash/maphash"
+	"iter"
+	"sync"
+)
+
+var _ collection.Map[int, int] = (*ConcurrentHashMap[int, int])(nil)
+
+const defaultShards = 32
+
+type shard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	items *HashMap[K, V]
+}
+
+// ConcurrentHashMap is a thread-safe Map split into shards, each a HashMap
+// behind its own lock, so goroutines working on different keys rarely contend.
+//
+// The Compute and Merge callbacks run while the key's shard is locked and must
+// not call back into the map. Iteration copies one shard at a time, so it
+// never blocks writers for long but is only weakly consistent.
+type ConcurrentHashMap[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []*shard[K, V]
+}
+
+func NewConcurrentHashMap[K comparable, V any]() *ConcurrentHashMap[K, V] {
+	return NewConcurrentHashMapWithShards[K, V](defaultShards)
+}
+
+func NewConcurrentHashMapWithShards[K comparable, V any](shards int) *ConcurrentHashMap[K, V] {
+	shards = max(shards, 1)
+	m := &ConcurrentHashMap[K, V]{
+		seed:   maphash.MakeSeed(),
+		shards: make([]*shard[K, V], shards),
+	}
+	for i := range m.shards {
+		m.shards[i] = &shard[K, V]{items: NewHashMap[K, V]()}
+	}
+	return m
+}
+
+func (s *ConcurrentHashMap[K, V]) Size() int {
+	size := 0
+	for _, shard := range s.shards {
+		shard.mu.RLock()
+		size += shard.items.Size()
+		shard.mu.RUnlock()
+	}
+	return size
+}
+
+func (s *ConcurrentHashMap[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *ConcurrentHashMap[K, V]) Clear() {
+	for _, shard := range s.shards {
+		shard.mu.Lock()
+		shard.items.Clear()
+		shard.mu.Unlock()
+	}
+}
+
+func (s *ConcurrentHashMap[K, V]) Put(key K, value V) {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	shard.items.Put(key, value)
+}
+
+func (s *ConcurrentHashMap[K, V]) Get(key K) (V, bool) {
+	shard := s.shardOf(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	return shard.items.Get(key)
+}
+
+func (s *ConcurrentHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	shard := s.shardOf(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	return shard.items.GetOrDefault(key, defaultValue)
+}
+
+func (s *ConcurrentHashMap[K, V]) ContainsKey(key K) bool {
+	shard := s.shardOf(key)
+	shard.mu.RLock()
+	defer shard.mu.RUnlock()
+
+	return shard.items.ContainsKey(key)
+}
+
+func (s *ConcurrentHashMap[K, V]) Remove(key K) (V, bool) {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return shard.items.Remove(key)
+}
+
+func (s *ConcurrentHashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return shard.items.PutIfAbsent(key, value)
+}
+
+// ComputeIfAbsent calls mapping at most once per missing key, even when many
+// goroutines ask for the same key at the same time.
+func (s *ConcurrentHashMap[K, V]) ComputeIfAbsent(key K, mapping func(K) V) V {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return shard.items.ComputeIfAbsent(key, mapping)
+}
+
+func (s *ConcurrentHashMap[K, V]) ComputeIfPresent(key K, remapping func(K, V) (V, bool)) (V, bool) {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return shard.items.ComputeIfPresent(key, remapping)
+}
+
+func (s *ConcurrentHashMap[K, V]) Merge(key K, value V, remapping func(V, V) (V, bool)) (V, bool) {
+	shard := s.shardOf(key)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+
+	return shard.items.Merge(key, value, remapping)
+}
+
+func (s *ConcurrentHashMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range s.Entries() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ConcurrentHashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range s.Entries() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *ConcurrentHashMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, shard := range s.shards {
+			shard.mu.RLock()
+			items := shard.items.Clone()
+			shard.mu.RUnlock()
+
+			for key, value := range items.Entries() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *ConcurrentHashMap[K, V]) shardOf(key K) *shard[K, V] {
+	return s.shards[maphash.Comparable(s.seed, key)%uint64(len(s.shards))]
+}
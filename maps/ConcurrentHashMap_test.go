@@ -0,0 +1,79 @@
+package maps
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentHashMap_Stress(t *testing.T) {
+	m := NewConcurrentHashMap[string, int]()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				key := strconv.Itoa(i % 100)
+				m.Merge(key, 1, func(a, b int) (int, bool) { return a + b, true })
+				m.Put(strconv.Itoa(w)+"-"+strconv.Itoa(i), i)
+				_, _ = m.Get(key)
+				_ = m.Size()
+				if i%50 == 0 {
+					for range m.Entries() {
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, 100+16*500, m.Size(), "ConcurrentHashMap size is not equal")
+	for i := 0; i < 100; i++ {
+		value, ok := m.Get(strconv.Itoa(i))
+		require.True(t, ok, "ConcurrentHashMap get is failed")
+		require.Equal(t, 16*5, value, "ConcurrentHashMap merge count is not matched")
+	}
+}
+
+func TestConcurrentHashMap_ComputeIfAbsentOnce(t *testing.T) {
+	m := NewConcurrentHashMapWithShards[int, int](4)
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for w := 0; w < 16; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := 0; key < 100; key++ {
+				value := m.ComputeIfAbsent(key, func(k int) int {
+					calls.Add(1)
+					return k * 2
+				})
+				assert.Equal(t, key*2, value, "ConcurrentHashMap compute if absent is not matched")
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, int32(100), calls.Load(), "ConcurrentHashMap mapping was called more than once per key")
+	require.Equal(t, 100, m.Size(), "ConcurrentHashMap size is not equal")
+}
+
+func TestConcurrentHashMap_MutateWhileIterating(t *testing.T) {
+	m := NewConcurrentHashMap[int, int]()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+
+	// iteration works on copies, so it can mutate the map without deadlocking
+	for key := range m.Keys() {
+		m.Remove(key)
+	}
+	require.True(t, m.IsEmpty(), "ConcurrentHashMap is not empty")
+}
@@ -0,0 +1,162 @@
+package maps
+
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+var _ collection.Map[int, int] = (*HashMap[int, int])(nil)
+
+// HashMap is a Map backed by a Go map; iteration order is unspecified.
+type HashMap[K comparable, V any] struct {
+	items map[K]V
+}
+
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return &HashMap[K, V]{items: map[K]V{}}
+}
+
+func (s *HashMap[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *HashMap[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *HashMap[K, V]) Clear() {
+	s.items = map[K]V{}
+}
+
+func (s *HashMap[K, V]) Put(key K, value V) {
+	s.items[key] = value
+}
+
+func (s *HashMap[K, V]) PutAll(other collection.Map[K, V]) {
+	for key, value := range other.Entries() {
+		s.Put(key, value)
+	}
+}
+
+func (s *HashMap[K, V]) Get(key K) (V, bool) {
+	value, ok := s.items[key]
+	return value, ok
+}
+
+func (s *HashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := s.items[key]; ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (s *HashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := s.items[key]
+	return ok
+}
+
+func (s *HashMap[K, V]) Remove(key K) (V, bool) {
+	value, ok := s.items[key]
+	if ok {
+		delete(s.items, key)
+	}
+	return value, ok
+}
+
+// PutIfAbsent stores the value only if the key is missing. It returns the
+// value now associated with the key and whether the key was already present.
+func (s *HashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	if current, ok := s.items[key]; ok {
+		return current, true
+	}
+
+	s.items[key] = value
+	return value, false
+}
+
+func (s *HashMap[K, V]) ComputeIfAbsent(key K, mapping func(K) V) V {
+	if current, ok := s.items[key]; ok {
+		return current
+	}
+
+	value := mapping(key)
+	s.items[key] = value
+	return value
+}
+
+// ComputeIfPresent replaces the value of an existing key with the result of
+// remapping, or removes the key if remapping returns false.
+func (s *HashMap[K, V]) ComputeIfPresent(key K, remapping func(K, V) (V, bool)) (V, bool) {
+	current, ok := s.items[key]
+	if !ok {
+		return current, false
+	}
+
+	value, keep := remapping(key, current)
+	if !keep {
+		delete(s.items, key)
+		var zero V
+		return zero, false
+	}
+
+	s.items[key] = value
+	return value, true
+}
+
+// Merge stores value for a missing key, otherwise combines it with the current
+// value through remapping, removing the key if remapping returns false.
+func (s *HashMap[K, V]) Merge(key K, value V, remapping func(V, V) (V, bool)) (V, bool) {
+	current, ok := s.items[key]
+	if !ok {
+		s.items[key] = value
+		return value, true
+	}
+
+	merged, keep := remapping(current, value)
+	if !keep {
+		delete(s.items, key)
+		var zero V
+		return zero, false
+	}
+
+	s.items[key] = merged
+	return merged, true
+}
+
+func (s *HashMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range s.items {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func (s *HashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range s.items {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *HashMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range s.items {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *HashMap[K, V]) Clone() *HashMap[K, V] {
+	items := make(map[K]V, len(s.items))
+	for key, value := range s.items {
+		items[key] = value
+	}
+	return &HashMap[K, V]{items: items}
+}
@@ -0,0 +1,24 @@
+package maps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashMap_PutAll(t *testing.T) {
+	source := NewLinkedHashMap[string, int]()
+	source.Put("a", 1)
+	source.Put("b", 2)
+
+	m := NewHashMap[string, int]()
+	m.Put("a", 10)
+	m.PutAll(source)
+	require.Equal(t, 2, m.Size(), "HashMap size is not equal")
+	require.Equal(t, 1, m.GetOrDefault("a", 0), "HashMap get(a) is not matched")
+
+	clone := m.Clone()
+	clone.Put("c", 3)
+	require.False(t, m.ContainsKey("c"), "HashMap clone is not a copy")
+	require.Equal(t, 3, clone.Size(), "HashMap clone size is not equal")
+}
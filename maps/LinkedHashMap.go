@@ -0,0 +1,256 @@
+package maps
+
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+var _ collection.Map[int, int] = (*LinkedHashMap[int, int])(nil)
+
+type entryNode[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *entryNode[K, V]
+	next  *entryNode[K, V]
+}
+
+// LinkedHashMap is a Map that iterates in insertion order or, when created
+// with NewAccessOrderLinkedHashMap, from least to most recently accessed.
+// Entries are kept in a doubly linked list between sentinel head and tail
+// nodes, like list.LinkedList.
+type LinkedHashMap[K comparable, V any] struct {
+	items       map[K]*entryNode[K, V]
+	head        *entryNode[K, V]
+	tail        *entryNode[K, V]
+	accessOrder bool
+}
+
+func NewLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	head := &entryNode[K, V]{}
+	tail := &entryNode[K, V]{}
+	head.next = tail
+	tail.prev = head
+	return &LinkedHashMap[K, V]{
+		items: map[K]*entryNode[K, V]{},
+		head:  head,
+		tail:  tail,
+	}
+}
+
+// NewAccessOrderLinkedHashMap creates a LinkedHashMap where Get, Put and the
+// Compute methods move the key to the end of the iteration order.
+func NewAccessOrderLinkedHashMap[K comparable, V any]() *LinkedHashMap[K, V] {
+	m := NewLinkedHashMap[K, V]()
+	m.accessOrder = true
+	return m
+}
+
+func (s *LinkedHashMap[K, V]) Size() int {
+	return len(s.items)
+}
+
+func (s *LinkedHashMap[K, V]) IsEmpty() bool {
+	return s.Size() == 0
+}
+
+func (s *LinkedHashMap[K, V]) Clear() {
+	s.items = map[K]*entryNode[K, V]{}
+	s.head.next = s.tail
+	s.tail.prev = s.head
+}
+
+func (s *LinkedHashMap[K, V]) Put(key K, value V) {
+	if node, ok := s.items[key]; ok {
+		node.value = value
+		s.access(node)
+		return
+	}
+
+	s.attach(key, value)
+}
+
+func (s *LinkedHashMap[K, V]) PutAll(other collection.Map[K, V]) {
+	for key, value := range other.Entries() {
+		s.Put(key, value)
+	}
+}
+
+func (s *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	s.access(node)
+	return node.value, true
+}
+
+func (s *LinkedHashMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := s.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (s *LinkedHashMap[K, V]) ContainsKey(key K) bool {
+	_, ok := s.items[key]
+	return ok
+}
+
+func (s *LinkedHashMap[K, V]) Remove(key K) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	s.detach(node)
+	return node.value, true
+}
+
+// PutIfAbsent stores the value only if the key is missing. It returns the
+// value now associated with the key and whether the key was already present.
+func (s *LinkedHashMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	if node, ok := s.items[key]; ok {
+		s.access(node)
+		return node.value, true
+	}
+
+	s.attach(key, value)
+	return value, false
+}
+
+func (s *LinkedHashMap[K, V]) ComputeIfAbsent(key K, mapping func(K) V) V {
+	if node, ok := s.items[key]; ok {
+		s.access(node)
+		return node.value
+	}
+
+	value := mapping(key)
+	s.attach(key, value)
+	return value
+}
+
+// ComputeIfPresent replaces the value of an existing key with the result of
+// remapping, or removes the key if remapping returns false.
+func (s *LinkedHashMap[K, V]) ComputeIfPresent(key K, remapping func(K, V) (V, bool)) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	value, keep := remapping(key, node.value)
+	if !keep {
+		s.detach(node)
+		var zero V
+		return zero, false
+	}
+
+	node.value = value
+	s.access(node)
+	return value, true
+}
+
+// Merge stores value for a missing key, otherwise combines it with the current
+// value through remapping, removing the key if remapping returns false.
+func (s *LinkedHashMap[K, V]) Merge(key K, value V, remapping func(V, V) (V, bool)) (V, bool) {
+	node, ok := s.items[key]
+	if !ok {
+		s.attach(key, value)
+		return value, true
+	}
+
+	merged, keep := remapping(node.value, value)
+	if !keep {
+		s.detach(node)
+		var zero V
+		return zero, false
+	}
+
+	node.value = merged
+	s.access(node)
+	return merged, true
+}
+
+// First returns the entry at the start of the iteration order: the eldest
+// insertion, or the least recently accessed entry in access order.
+func (s *LinkedHashMap[K, V]) First() (K, V, bool) {
+	if s.IsEmpty() {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+
+	node := s.head.next
+	return node.key, node.value, true
+}
+
+func (s *LinkedHashMap[K, V]) Last() (K, V, bool) {
+	if s.IsEmpty() {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+
+	node := s.tail.prev
+	return node.key, node.value, true
+}
+
+func (s *LinkedHashMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for node := s.head.next; node != s.tail; node = node.next {
+			if !yield(node.key) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LinkedHashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for node := s.head.next; node != s.tail; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LinkedHashMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := s.head.next; node != s.tail; node = node.next {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+func (s *LinkedHashMap[K, V]) attach(key K, value V) {
+	node := &entryNode[K, V]{key: key, value: value, prev: s.tail.prev, next: s.tail}
+	s.tail.prev.next = node
+	s.tail.prev = node
+	s.items[key] = node
+}
+
+func (s *LinkedHashMap[K, V]) detach(node *entryNode[K, V]) {
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	delete(s.items, node.key)
+}
+
+// access moves the node to the end of the list in access order.
+func (s *LinkedHashMap[K, V]) access(node *entryNode[K, V]) {
+	if !s.accessOrder || node.next == s.tail {
+		return
+	}
+
+	node.prev.next = node.next
+	node.next.prev = node.prev
+	node.prev = s.tail.prev
+	node.next = s.tail
+	s.tail.prev.next = node
+	s.tail.prev = node
+}
@@ -0,0 +1,59 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkedHashMap_InsertionOrder(t *testing.T) {
+	m := NewLinkedHashMap[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("a", 10)
+	_, _ = m.Get("c")
+
+	require.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.Keys()), "LinkedHashMap keys are not in insertion order")
+	require.Equal(t, []int{3, 10, 2}, slices.Collect(m.Values()), "LinkedHashMap values are not in insertion order")
+
+	m.Remove("a")
+	m.Put("a", 1)
+	require.Equal(t, []string{"c", "b", "a"}, slices.Collect(m.Keys()), "LinkedHashMap keys are not in insertion order")
+
+	key, value, ok := m.First()
+	require.True(t, ok, "LinkedHashMap first is failed")
+	require.Equal(t, "c", key, "LinkedHashMap first key is not matched")
+	require.Equal(t, 3, value, "LinkedHashMap first value is not matched")
+	key, _, _ = m.Last()
+	require.Equal(t, "a", key, "LinkedHashMap last key is not matched")
+}
+
+func TestLinkedHashMap_AccessOrder(t *testing.T) {
+	m := NewAccessOrderLinkedHashMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+
+	_, _ = m.Get("a")
+	require.Equal(t, []string{"b", "c", "a"}, slices.Collect(m.Keys()), "LinkedHashMap keys are not in access order")
+
+	m.Put("b", 20)
+	require.Equal(t, []string{"c", "a", "b"}, slices.Collect(m.Keys()), "LinkedHashMap keys are not in access order")
+
+	m.ComputeIfAbsent("c", func(string) int { return 0 })
+	require.Equal(t, []string{"a", "b", "c"}, slices.Collect(m.Keys()), "LinkedHashMap keys are not in access order")
+
+	// ContainsKey and iteration do not count as access
+	m.ContainsKey("a")
+	for range m.Entries() {
+	}
+	key, _, _ := m.First()
+	require.Equal(t, "a", key, "LinkedHashMap least recently used key is not matched")
+
+	m.Clear()
+	_, _, ok := m.First()
+	require.False(t, ok, "LinkedHashMap first on empty is not failed")
+	require.Empty(t, slices.Collect(m.Keys()), "LinkedHashMap keys are not empty")
+}
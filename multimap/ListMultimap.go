@@ -0,0 +1,132 @@
+package multimap
+
+import (
+	"go-utils/array"
+	"go-utils/maps"
+	"iter"
+	"slices"
+)
+
+// ListMultimap maps each key to an array.Array of values, keeping duplicates
+// and insertion order. Keys iterate in first-seen order.
+type ListMultimap[K comparable, V comparable] struct {
+	items *maps.LinkedHashMap[K, *array.Array[V]]
+	size  int
+}
+
+func NewListMultimap[K comparable, V comparable]() *ListMultimap[K, V] {
+	return &ListMultimap[K, V]{items: maps.NewLinkedHashMap[K, *array.Array[V]]()}
+}
+
+// Size returns the number of key-value pairs.
+func (s *ListMultimap[K, V]) Size() int {
+	return s.size
+}
+
+func (s *ListMultimap[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *ListMultimap[K, V]) Clear() {
+	s.items.Clear()
+	s.size = 0
+}
+
+// Put appends the value to the key's values. It always returns true.
+func (s *ListMultimap[K, V]) Put(key K, value V) bool {
+	values := s.items.ComputeIfAbsent(key, func(K) *array.Array[V] { return array.NewArrayList[V]() })
+	values.Add(value)
+	s.size++
+	return true
+}
+
+func (s *ListMultimap[K, V]) PutAll(key K, values []V) {
+	for _, value := range values {
+		s.Put(key, value)
+	}
+}
+
+// Get returns a copy of the key's values, or an empty slice.
+func (s *ListMultimap[K, V]) Get(key K) []V {
+	values, ok := s.items.Get(key)
+	if !ok {
+		return []V{}
+	}
+	return slices.Clone(values.Values())
+}
+
+func (s *ListMultimap[K, V]) ContainsKey(key K) bool {
+	return s.items.ContainsKey(key)
+}
+
+func (s *ListMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	values, ok := s.items.Get(key)
+	return ok && values.Contains(value)
+}
+
+// Remove removes the first occurrence of the value from the key's values.
+func (s *ListMultimap[K, V]) Remove(key K, value V) bool {
+	values, ok := s.items.Get(key)
+	if !ok {
+		return false
+	}
+
+	index := slices.Index(values.Values(), value)
+	if index < 0 {
+		return false
+	}
+
+	_, _ = values.RemoveAt(index)
+	s.size--
+	if values.IsEmpty() {
+		s.items.Remove(key)
+	}
+	return true
+}
+
+// RemoveAll removes the key and returns the values it had.
+func (s *ListMultimap[K, V]) RemoveAll(key K) []V {
+	values, ok := s.items.Remove(key)
+	if !ok {
+		return []V{}
+	}
+
+	s.size -= values.Size()
+	return values.Values()
+}
+
+// Keys yields each distinct key once.
+func (s *ListMultimap[K, V]) Keys() iter.Seq[K] {
+	return s.items.Keys()
+}
+
+// Entries yields every key-value pair.
+func (s *ListMultimap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range s.items.Entries() {
+			for _, value := range values.Values() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// AsMap returns a copy of the multimap as a map of value slices.
+func (s *ListMultimap[K, V]) AsMap() map[K][]V {
+	result := make(map[K][]V, s.items.Size())
+	for key, values := range s.items.Entries() {
+		result[key] = slices.Clone(values.Values())
+	}
+	return result
+}
+
+// Inverse returns a new multimap with every pair reversed.
+func (s *ListMultimap[K, V]) Inverse() *ListMultimap[V, K] {
+	inverse := NewListMultimap[V, K]()
+	for key, value := range s.Entries() {
+		inverse.Put(value, key)
+	}
+	return inverse
+}
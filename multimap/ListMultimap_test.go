@@ -0,0 +1,51 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMultimap(t *testing.T) {
+	m := NewListMultimap[string, int]()
+	require.True(t, m.IsEmpty(), "ListMultimap is not empty")
+	require.Equal(t, []int{}, m.Get("a"), "ListMultimap get(a) is not empty")
+
+	m.Put("a", 1)
+	m.PutAll("b", []int{2, 3})
+	m.PutAll("a", []int{1, 4})
+	require.Equal(t, 5, m.Size(), "ListMultimap size is not equal")
+	require.Equal(t, []int{1, 1, 4}, m.Get("a"), "ListMultimap get(a) is not matched")
+	require.True(t, m.ContainsEntry("b", 3), "ListMultimap contains entry(b, 3) is not matched")
+	require.False(t, m.ContainsEntry("b", 1), "ListMultimap contains entry(b, 1) is not matched")
+
+	keys := []string{}
+	for key := range m.Keys() {
+		keys = append(keys, key)
+	}
+	require.Equal(t, []string{"a", "b"}, keys, "ListMultimap keys are not matched")
+	require.Equal(t, map[string][]int{"a": {1, 1, 4}, "b": {2, 3}}, m.AsMap(), "ListMultimap as map is not matched")
+
+	// Get and AsMap return copies
+	m.Get("a")[0] = 100
+	m.AsMap()["a"][0] = 100
+	require.Equal(t, []int{1, 1, 4}, m.Get("a"), "ListMultimap get(a) is not a copy")
+
+	inverse := m.Inverse()
+	require.Equal(t, []string{"a", "a"}, inverse.Get(1), "ListMultimap inverse get(1) is not matched")
+	require.Equal(t, []string{"b"}, inverse.Get(3), "ListMultimap inverse get(3) is not matched")
+	require.Equal(t, 5, inverse.Size(), "ListMultimap inverse size is not equal")
+
+	require.True(t, m.Remove("a", 1), "ListMultimap remove(a, 1) is not matched")
+	require.Equal(t, []int{1, 4}, m.Get("a"), "ListMultimap get(a) is not matched")
+	require.False(t, m.Remove("a", 9), "ListMultimap remove(a, 9) is not matched")
+
+	require.Equal(t, []int{2, 3}, m.RemoveAll("b"), "ListMultimap remove all(b) is not matched")
+	require.False(t, m.ContainsKey("b"), "ListMultimap contains key(b) is not matched")
+	require.Equal(t, 2, m.Size(), "ListMultimap size is not equal")
+
+	m.Remove("a", 1)
+	m.Remove("a", 4)
+	require.False(t, m.ContainsKey("a"), "ListMultimap empty key is not removed")
+	require.True(t, m.IsEmpty(), "ListMultimap is not empty")
+}
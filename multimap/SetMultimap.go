@@ -0,0 +1,127 @@
+package multimap
+
+import (
+	"go-utils/maps"
+	"go-utils/set"
+	"iter"
+)
+
+// SetMultimap maps each key to a set.LinkedHashSet of values, so a key holds
+// each value at most once. Keys and values iterate in first-seen order.
+type SetMultimap[K comparable, V comparable] struct {
+	items *maps.LinkedHashMap[K, *set.LinkedHashSet[V]]
+	size  int
+}
+
+func NewSetMultimap[K comparable, V comparable]() *SetMultimap[K, V] {
+	return &SetMultimap[K, V]{items: maps.NewLinkedHashMap[K, *set.LinkedHashSet[V]]()}
+}
+
+// Size returns the number of key-value pairs.
+func (s *SetMultimap[K, V]) Size() int {
+	return s.size
+}
+
+func (s *SetMultimap[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+func (s *SetMultimap[K, V]) Clear() {
+	s.items.Clear()
+	s.size = 0
+}
+
+// Put adds the value to the key's values and reports whether it was missing.
+func (s *SetMultimap[K, V]) Put(key K, value V) bool {
+	values := s.items.ComputeIfAbsent(key, func(K) *set.LinkedHashSet[V] { return set.NewLinkedHashSet[V]() })
+	if !values.Add(value) {
+		return false
+	}
+
+	s.size++
+	return true
+}
+
+func (s *SetMultimap[K, V]) PutAll(key K, values []V) {
+	for _, value := range values {
+		s.Put(key, value)
+	}
+}
+
+// Get returns a copy of the key's values, or an empty slice.
+func (s *SetMultimap[K, V]) Get(key K) []V {
+	values, ok := s.items.Get(key)
+	if !ok {
+		return []V{}
+	}
+	return values.Values()
+}
+
+func (s *SetMultimap[K, V]) ContainsKey(key K) bool {
+	return s.items.ContainsKey(key)
+}
+
+func (s *SetMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	values, ok := s.items.Get(key)
+	return ok && values.Contains(value)
+}
+
+func (s *SetMultimap[K, V]) Remove(key K, value V) bool {
+	values, ok := s.items.Get(key)
+	if !ok || !values.Remove(value) {
+		return false
+	}
+
+	s.size--
+	if values.IsEmpty() {
+		s.items.Remove(key)
+	}
+	return true
+}
+
+// RemoveAll removes the key and returns the values it had.
+func (s *SetMultimap[K, V]) RemoveAll(key K) []V {
+	values, ok := s.items.Remove(key)
+	if !ok {
+		return []V{}
+	}
+
+	s.size -= values.Size()
+	return values.Values()
+}
+
+// Keys yields each distinct key once.
+func (s *SetMultimap[K, V]) Keys() iter.Seq[K] {
+	return s.items.Keys()
+}
+
+// Entries yields every key-value pair.
+func (s *SetMultimap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, values := range s.items.Entries() {
+			for value := range values.All() {
+				if !yield(key, value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// AsMap returns a copy of the multimap as a map of value slices.
+func (s *SetMultimap[K, V]) AsMap() map[K][]V {
+	result := make(map[K][]V, s.items.Size())
+	for key, values := range s.items.Entries() {
+		result[key] = values.Values()
+	}
+	return result
+}
+
+// Inverse returns a new multimap with every pair reversed.
+func (s *SetMultimap[K, V]) Inverse() *SetMultimap[V, K] {
+	inverse := NewSetMultimap[V, K]()
+	for key, value := range s.Entries() {
+		inverse.Put(value, key)
+	}
+	return inverse
+}
@@ -0,0 +1,38 @@
+package multimap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMultimap(t *testing.T) {
+	m := NewSetMultimap[string, int]()
+	require.True(t, m.Put("a", 1), "SetMultimap put(a, 1) is not matched")
+	require.False(t, m.Put("a", 1), "SetMultimap put(a, 1) is not matched")
+	m.PutAll("a", []int{3, 2, 3})
+	m.PutAll("b", []int{2})
+	require.Equal(t, 4, m.Size(), "SetMultimap size is not equal")
+	require.Equal(t, []int{1, 3, 2}, m.Get("a"), "SetMultimap get(a) is not matched")
+
+	pairs := 0
+	for range m.Entries() {
+		pairs++
+	}
+	require.Equal(t, 4, pairs, "SetMultimap entries are not matched")
+	require.Equal(t, map[string][]int{"a": {1, 3, 2}, "b": {2}}, m.AsMap(), "SetMultimap as map is not matched")
+
+	inverse := m.Inverse()
+	require.Equal(t, []string{"a", "b"}, inverse.Get(2), "SetMultimap inverse get(2) is not matched")
+	require.Equal(t, 4, inverse.Size(), "SetMultimap inverse size is not equal")
+
+	require.True(t, m.Remove("a", 3), "SetMultimap remove(a, 3) is not matched")
+	require.False(t, m.Remove("a", 3), "SetMultimap remove(a, 3) is not matched")
+	require.Equal(t, []int{1, 2}, m.RemoveAll("a"), "SetMultimap remove all(a) is not matched")
+	require.Equal(t, []int{}, m.RemoveAll("a"), "SetMultimap remove all(a) is not empty")
+	require.Equal(t, 1, m.Size(), "SetMultimap size is not equal")
+
+	m.Clear()
+	require.True(t, m.IsEmpty(), "SetMultimap is not empty")
+	require.False(t, m.ContainsKey("b"), "SetMultimap contains key(b) is not matched")
+}
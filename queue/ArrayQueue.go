@@ -0,0 +1,59 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"go-utils/deque"
+)
+
+var (
+	_ collection.Queue[int] = (*ArrayQueue[int])(nil)
+	_ collection.Deque[int] = (*ArrayQueue[int])(nil)
+)
+
+// ArrayQueue is a Queue backed by a deque.ArrayDeque instead of a
+// list.LinkedList, which avoids an allocation per value and makes GetAt O(1).
+type ArrayQueue[T comparable] struct {
+	*deque.ArrayDeque[T]
+}
+
+func NewArrayQueue[T comparable]() *ArrayQueue[T] {
+	return &ArrayQueue[T]{deque.NewArrayDeque[T]()}
+}
+
+func (s *ArrayQueue[T]) Offer(value T) {
+	s.AddTail(value)
+}
+
+func (s *ArrayQueue[T]) OfferValues(values []T) {
+	s.AddAll(values)
+}
+
+func (s *ArrayQueue[T]) Poll() (T, error) {
+	return s.RemoveHead()
+}
+
+func (s *ArrayQueue[T]) Peek() (T, error) {
+	return s.GetHead()
+}
+
+// MustPoll is like Poll but panics on error.
+func (s *ArrayQueue[T]) MustPoll() T {
+	return collection.Must(s.Poll())
+}
+
+// MustPeek is like Peek but panics on error.
+func (s *ArrayQueue[T]) MustPeek() T {
+	return collection.Must(s.Peek())
+}
+
+func (s *ArrayQueue[T]) Clone() *ArrayQueue[T] {
+	return &ArrayQueue[T]{s.ArrayDeque.Clone()}
+}
+
+func (s *ArrayQueue[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{s.ArrayDeque.Iterator()}
+}
+
+func (s *ArrayQueue[T]) DescendingIterator() *DescendingIterator[T] {
+	return &DescendingIterator[T]{s.ArrayDeque.ReverseIterator()}
+}
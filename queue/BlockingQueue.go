@@ -0,0 +1,214 @@
+package queue
+
+import (
+	"context"
+	"go-utils/collection"
+	"math"
+	"sync"
+	"time"
+)
+
+var _ collection.Collection[int] = (*BlockingQueue[int])(nil)
+
+// ErrQueueClosed is the collection.ErrClosed of the blocking queues.
+var ErrQueueClosed = collection.ErrClosed
+
+// BlockingQueue is a FIFO queue whose Put and Take wait for space and for
+// values respectively. A capacity of zero or less makes the queue unbounded.
+type BlockingQueue[T comparable] struct {
+	mu       sync.Mutex
+	queue    *Queue[T]
+	capacity int
+	closed   bool
+	changed  signal
+}
+
+func NewBlockingQueue[T comparable](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		capacity = math.MaxInt
+	}
+
+	return &BlockingQueue[T]{
+		queue:    NewQueue[T](),
+		capacity: capacity,
+		changed:  newSignal(),
+	}
+}
+
+func (q *BlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+func (q *BlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+func (q *BlockingQueue[T]) Values() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Values()
+}
+
+func (q *BlockingQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+	q.changed.broadcast()
+}
+
+func (q *BlockingQueue[T]) Contains(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Contains(value)
+}
+
+func (q *BlockingQueue[T]) RemainingCapacity() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.capacity - q.queue.Size()
+}
+
+// Offer adds the value without waiting and reports whether it was added.
+func (q *BlockingQueue[T]) Offer(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || q.queue.Size() >= q.capacity {
+		return false
+	}
+
+	q.queue.Offer(value)
+	q.changed.broadcast()
+	return true
+}
+
+func (q *BlockingQueue[T]) Put(value T) error {
+	return q.PutContext(context.Background(), value)
+}
+
+func (q *BlockingQueue[T]) OfferTimeout(value T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.PutContext(ctx, value)
+}
+
+// PutContext waits until there is space for the value. It fails with
+// ErrQueueClosed once the queue is closed, or with the context's error.
+func (q *BlockingQueue[T]) PutContext(ctx context.Context, value T) error {
+	q.mu.Lock()
+	for {
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if q.queue.Size() < q.capacity {
+			break
+		}
+
+		if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+			return err
+		}
+	}
+	defer q.mu.Unlock()
+
+	q.queue.Offer(value)
+	q.changed.broadcast()
+	return nil
+}
+
+// Poll removes the head without waiting.
+func (q *BlockingQueue[T]) Poll() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	value, err := q.queue.Poll()
+	if err == nil {
+		q.changed.broadcast()
+	}
+	return value, err
+}
+
+func (q *BlockingQueue[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Peek()
+}
+
+func (q *BlockingQueue[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+func (q *BlockingQueue[T]) PollTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.TakeContext(ctx)
+}
+
+// TakeContext waits until a value is available. Values offered before Close
+// can still be taken; after that it fails with ErrQueueClosed.
+func (q *BlockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for q.queue.IsEmpty() {
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+
+		if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	defer q.mu.Unlock()
+
+	value, _ := q.queue.Poll()
+	q.changed.broadcast()
+	return value, nil
+}
+
+// DrainTo moves every available value into target and returns how many were moved.
+func (q *BlockingQueue[T]) DrainTo(target collection.Queue[T]) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	count := 0
+	for !q.queue.IsEmpty() {
+		value, _ := q.queue.Poll()
+		target.Offer(value)
+		count++
+	}
+	if count > 0 {
+		q.changed.broadcast()
+	}
+	return count
+}
+
+// Close rejects further puts and wakes up every waiting goroutine.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.changed.broadcast()
+}
+
+func (q *BlockingQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
@@ -0,0 +1,187 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	queue := NewBlockingQueue[int](2)
+	require.True(t, queue.IsEmpty(), "BlockingQueue is not empty")
+	require.Equal(t, 2, queue.RemainingCapacity(), "BlockingQueue remaining capacity is not equal")
+
+	require.True(t, queue.Offer(1), "BlockingQueue offer is failed")
+	require.Nil(t, queue.Put(2), "BlockingQueue put is failed")
+	require.False(t, queue.Offer(3), "BlockingQueue offer over capacity is not rejected")
+	require.Equal(t, 0, queue.RemainingCapacity(), "BlockingQueue remaining capacity is not equal")
+	require.Equal(t, []int{1, 2}, queue.Values(), "BlockingQueue values are not equal")
+	require.True(t, queue.Contains(2), "BlockingQueue contains(2) is not matched")
+
+	err := queue.OfferTimeout(3, 20*time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "BlockingQueue offer timeout is not expired")
+
+	value, err := queue.Peek()
+	require.Nil(t, err, "BlockingQueue peek is failed")
+	require.Equal(t, 1, value, "BlockingQueue peek item is not matched")
+
+	value, err = queue.Take()
+	require.Nil(t, err, "BlockingQueue take is failed")
+	require.Equal(t, 1, value, "BlockingQueue take item is not matched")
+
+	value, err = queue.Poll()
+	require.Nil(t, err, "BlockingQueue poll is failed")
+	require.Equal(t, 2, value, "BlockingQueue poll item is not matched")
+
+	_, err = queue.Poll()
+	require.NotNil(t, err, "BlockingQueue poll on empty is not failed")
+	_, err = queue.PollTimeout(20 * time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "BlockingQueue poll timeout is not expired")
+}
+
+func TestBlockingQueue_TakeWaitsForPut(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = queue.Put(10)
+	}()
+
+	start := time.Now()
+	value, err := queue.Take()
+	require.Nil(t, err, "BlockingQueue take is failed")
+	require.Equal(t, 10, value, "BlockingQueue take item is not matched")
+	require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "BlockingQueue take did not block")
+}
+
+func TestBlockingQueue_PutWaitsForTake(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	require.Nil(t, queue.Put(1), "BlockingQueue put is failed")
+
+	done := make(chan error)
+	go func() {
+		done <- queue.Put(2)
+	}()
+
+	select {
+	case <-done:
+		require.Fail(t, "BlockingQueue put did not block on full queue")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	value, _ := queue.Take()
+	require.Equal(t, 1, value, "BlockingQueue take item is not matched")
+	require.Nil(t, <-done, "BlockingQueue put is failed")
+	require.Equal(t, []int{2}, queue.Values(), "BlockingQueue values are not equal")
+}
+
+func TestBlockingQueue_Context(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := queue.TakeContext(ctx)
+	require.ErrorIs(t, err, context.Canceled, "BlockingQueue take is not cancelled")
+
+	require.Nil(t, queue.PutContext(context.Background(), 1), "BlockingQueue put is failed")
+	err = queue.PutContext(ctx, 2)
+	require.ErrorIs(t, err, context.Canceled, "BlockingQueue put is not cancelled")
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := queue.Take()
+			errs <- err
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	queue.Close()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.ErrorIs(t, err, ErrQueueClosed, "BlockingQueue take is not woken up by close")
+	}
+
+	require.True(t, queue.IsClosed(), "BlockingQueue is not closed")
+	require.ErrorIs(t, queue.Put(1), ErrQueueClosed, "BlockingQueue put after close is not failed")
+	require.False(t, queue.Offer(1), "BlockingQueue offer after close is not rejected")
+}
+
+func TestBlockingQueue_CloseDrainsRemaining(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	_ = queue.Put(1)
+	_ = queue.Put(2)
+	queue.Close()
+
+	value, err := queue.Take()
+	require.Nil(t, err, "BlockingQueue take is failed")
+	require.Equal(t, 1, value, "BlockingQueue take item is not matched")
+
+	target := NewQueue[int]()
+	require.Equal(t, 1, queue.DrainTo(target), "BlockingQueue drain count is not equal")
+	require.Equal(t, []int{2}, target.Values(), "BlockingQueue drained values are not equal")
+
+	_, err = queue.Take()
+	require.ErrorIs(t, err, ErrQueueClosed, "BlockingQueue take after close is not failed")
+}
+
+func TestBlockingQueue_ProducerConsumer(t *testing.T) {
+	queue := NewBlockingQueue[int](4)
+
+	var producers sync.WaitGroup
+	for p := 0; p < 5; p++ {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			for i := 0; i < 100; i++ {
+				assert.Nil(t, queue.Put(p*100+i), "BlockingQueue put is failed")
+			}
+		}()
+	}
+
+	var mutex sync.Mutex
+	var consumers sync.WaitGroup
+	consumed := []int{}
+	for c := 0; c < 5; c++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				value, err := queue.Take()
+				if err != nil {
+					return
+				}
+				mutex.Lock()
+				consumed = append(consumed, value)
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	producers.Wait()
+	queue.Close()
+	consumers.Wait()
+
+	sort.Ints(consumed)
+	require.Equal(t, 500, len(consumed), "BlockingQueue consumed size is not equal")
+	for i := 0; i < 500; i++ {
+		require.Equal(t, i, consumed[i], "BlockingQueue consumed item is not matched")
+	}
+}
@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"iter"
+	"slices"
+)
+
+var _ collection.Collection[int] = (*BoundedPriorityQueue[int])(nil)
+
+// BoundedPriorityQueue keeps the k values that come first per the comparator,
+// in O(k) memory however many values are offered. It is a PriorityQueue in
+// reverse order whose head is the worst value kept, so a value that would not
+// make the cut is rejected in O(1) and one that does replaces the head in
+// O(log k).
+//
+// To compute a top-k in parallel, fill one queue per goroutine and Merge the
+// partial results.
+type BoundedPriorityQueue[T comparable] struct {
+	heap       *PriorityQueue[T]
+	capacity   int
+	comparator func(a, b T) int
+}
+
+// NewBoundedPriorityQueue creates a queue keeping the k best values. If k is not
+// positive, every value is rejected.
+func NewBoundedPriorityQueue[T comparable](k int, comparator func(a, b T) int) *BoundedPriorityQueue[T] {
+	return &BoundedPriorityQueue[T]{
+		heap:       NewPriorityQueue[T](func(a, b T) int { return comparator(b, a) }),
+		capacity:   max(k, 0),
+		comparator: comparator,
+	}
+}
+
+func (q *BoundedPriorityQueue[T]) Size() int {
+	return q.heap.Size()
+}
+
+func (q *BoundedPriorityQueue[T]) IsEmpty() bool {
+	return q.heap.IsEmpty()
+}
+
+func (q *BoundedPriorityQueue[T]) Capacity() int {
+	return q.capacity
+}
+
+func (q *BoundedPriorityQueue[T]) IsFull() bool {
+	return q.heap.Size() >= q.capacity
+}
+
+// Values returns the values in heap order, not priority order.
+func (q *BoundedPriorityQueue[T]) Values() []T {
+	return slices.Clone(q.heap.Values())
+}
+
+func (q *BoundedPriorityQueue[T]) Clear() {
+	q.heap.Clear()
+}
+
+func (q *BoundedPriorityQueue[T]) Contains(value T) bool {
+	return q.heap.Contains(value)
+}
+
+// Offer adds the value if it is among the k best so far and reports whether it
+// was kept. When the queue is full the worst value is dropped to make room; a
+// value tied with the worst one is rejected.
+func (q *BoundedPriorityQueue[T]) Offer(value T) bool {
+	if q.capacity == 0 {
+		return false
+	}
+	if !q.IsFull() {
+		q.heap.Offer(value)
+		return true
+	}
+
+	worst, _ := q.heap.Peek()
+	if q.comparator(value, worst) >= 0 {
+		return false
+	}
+
+	q.heap.Replace(value)
+	return true
+}
+
+func (q *BoundedPriorityQueue[T]) OfferValues(values []T) {
+	for _, value := range values {
+		q.Offer(value)
+	}
+}
+
+// Merge offers the values of other, which is left unchanged, so the queue ends
+// up with the k best values of both.
+func (q *BoundedPriorityQueue[T]) Merge(other *BoundedPriorityQueue[T]) {
+	if other == q {
+		return
+	}
+	q.OfferValues(other.heap.Values())
+}
+
+// Threshold returns the worst value kept. Once the queue is full, values that
+// do not come before it are rejected.
+func (q *BoundedPriorityQueue[T]) Threshold() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.heap.Peek()
+}
+
+// SortedValues returns the values from best to worst without modifying the queue.
+func (q *BoundedPriorityQueue[T]) SortedValues() []T {
+	values := q.Values()
+	slices.SortStableFunc(values, q.comparator)
+	return values
+}
+
+// All yields the values from best to worst without modifying the queue.
+func (q *BoundedPriorityQueue[T]) All() iter.Seq[T] {
+	return slices.Values(q.SortedValues())
+}
+
+// TopK returns the k values of seq that come first per the comparator, from
+// best to worst, or none if k is not positive.
+func TopK[T comparable](seq iter.Seq[T], k int, comparator func(a, b T) int) []T {
+	if k <= 0 {
+		return []T{}
+	}
+
+	result := NewBoundedPriorityQueue[T](k, comparator)
+	for value := range seq {
+		result.Offer(value)
+	}
+	return result.SortedValues()
+}
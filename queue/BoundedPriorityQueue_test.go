@@ -0,0 +1,87 @@
+package queue
+
+import (
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoundedPriorityQueue(t *testing.T) {
+	queue := NewBoundedPriorityQueue[int](3, func(a, b int) int { return b - a })
+	_, err := queue.Threshold()
+	require.NotNil(t, err, "BoundedPriorityQueue threshold is not failed")
+
+	require.True(t, queue.Offer(4), "BoundedPriorityQueue offer(4) is not matched")
+	require.True(t, queue.Offer(8), "BoundedPriorityQueue offer(8) is not matched")
+	require.True(t, queue.Offer(1), "BoundedPriorityQueue offer(1) is not matched")
+	require.True(t, queue.IsFull(), "BoundedPriorityQueue is full is not matched")
+
+	require.True(t, queue.Offer(6), "BoundedPriorityQueue offer(6) is not matched")
+	require.False(t, queue.Offer(2), "BoundedPriorityQueue offer(2) is not matched")
+	require.False(t, queue.Offer(4), "BoundedPriorityQueue offer of a tie is not matched")
+	require.Equal(t, 3, queue.Size(), "BoundedPriorityQueue size is not equal")
+	require.False(t, queue.Contains(1), "BoundedPriorityQueue contains(1) is not matched")
+
+	threshold, _ := queue.Threshold()
+	require.Equal(t, 4, threshold, "BoundedPriorityQueue threshold is not matched")
+	require.Equal(t, []int{8, 6, 4}, queue.SortedValues(), "BoundedPriorityQueue sorted values are not equal")
+	require.Equal(t, []int{8, 6, 4}, slices.Collect(queue.All()), "BoundedPriorityQueue all is not matched")
+	require.ElementsMatch(t, []int{8, 6, 4}, queue.Values(), "BoundedPriorityQueue values are not equal")
+
+	queue.Clear()
+	require.True(t, queue.IsEmpty(), "BoundedPriorityQueue clear is not matched")
+}
+
+func TestBoundedPriorityQueue_Empty(t *testing.T) {
+	comparator := func(a, b int) int { return a - b }
+	for _, k := range []int{0, -1} {
+		queue := NewBoundedPriorityQueue[int](k, comparator)
+		require.Equal(t, 0, queue.Capacity(), "BoundedPriorityQueue capacity is not equal")
+		require.False(t, queue.Offer(1), "BoundedPriorityQueue offer is not rejected")
+		require.True(t, queue.IsEmpty(), "BoundedPriorityQueue is not empty")
+
+		require.Empty(t, TopK(slices.Values([]int{5, 3, 9}), k, comparator), "TopK(%d) is not empty", k)
+	}
+}
+
+func TestBoundedPriorityQueue_Merge(t *testing.T) {
+	comparator := func(a, b int) int { return a - b }
+	values := rand.New(rand.NewSource(1)).Perm(100_000)
+
+	partials := make([]*BoundedPriorityQueue[int], 8)
+	var wg sync.WaitGroup
+	for i := range partials {
+		partials[i] = NewBoundedPriorityQueue[int](100, comparator)
+		wg.Add(1)
+		go func(partial *BoundedPriorityQueue[int], values []int) {
+			defer wg.Done()
+			partial.OfferValues(values)
+		}(partials[i], values[i*len(values)/len(partials):(i+1)*len(values)/len(partials)])
+	}
+	wg.Wait()
+
+	result := NewBoundedPriorityQueue[int](100, comparator)
+	for _, partial := range partials {
+		result.Merge(partial)
+	}
+	result.Merge(result)
+
+	expected := make([]int, 100)
+	for i := range expected {
+		expected[i] = i
+	}
+	require.Equal(t, expected, result.SortedValues(), "BoundedPriorityQueue merge is not matched")
+	require.Equal(t, 100, partials[0].Size(), "BoundedPriorityQueue merge modified the other queue")
+}
+
+func TestTopK(t *testing.T) {
+	values := rand.New(rand.NewSource(1)).Perm(1000)
+	top := TopK(slices.Values(values), 5, func(a, b int) int { return b - a })
+	require.Equal(t, []int{999, 998, 997, 996, 995}, top, "TopK is not matched")
+
+	top = TopK(slices.Values([]int{3, 1}), 5, func(a, b int) int { return a - b })
+	require.Equal(t, []int{1, 3}, top, "TopK of fewer values is not matched")
+}
@@ -1,6 +1,11 @@
 package queue
 
-import "sync"
+import (
+    "go-utils/collection"
+    "sync"
+)
+
+var _ collection.Queue[int] = (*ConcurrentPriorityQueue[int])(nil)
 
 type ConcurrentPriorityQueue[T comparable] struct {
     mu    sync.RWMutex
@@ -12,10 +17,16 @@ func NewConcurrentPriorityQueue[T comparable](comparator func(a, b T) int) *Conc
 }
 
 func (s *ConcurrentPriorityQueue[T]) Size() int {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
     return s.queue.Size()
 }
 
 func (s *ConcurrentPriorityQueue[T]) IsEmpty() bool {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
     return s.queue.IsEmpty()
 }
 
@@ -26,11 +37,19 @@ func (s *ConcurrentPriorityQueue[T]) Clear() {
     s.queue.Clear()
 }
 
+// Values returns a copy of the heap, so the result can be used after the lock is released.
 func (s *ConcurrentPriorityQueue[T]) Values() []T {
     s.mu.RLock()
     defer s.mu.RUnlock()
 
-    return s.queue.Values()
+    return s.queue.Clone().Values()
+}
+
+func (s *ConcurrentPriorityQueue[T]) Contains(value T) bool {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
+    return s.queue.Contains(value)
 }
 
 func (s *ConcurrentPriorityQueue[T]) Offer(value T) {
@@ -93,3 +93,37 @@ func TestConcurrentPriorityQueue_MaxQueue(t *testing.T) {
     wg.Wait()
     require.True(t, queue.IsEmpty(), "ConcurrentPriorityQueue is not empty")
 }
+
+func TestConcurrentPriorityQueue_Stress(t *testing.T) {
+    comparator := func(a, b int) int { return a - b }
+    queue := NewConcurrentPriorityQueue[int](comparator)
+
+    var wg sync.WaitGroup
+    for w := 0; w < 16; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := 0; i < 500; i++ {
+                queue.Offer(w*500 + i)
+                _ = queue.Size()
+                _ = queue.IsEmpty()
+                _ = queue.Contains(i)
+                values := queue.Values()
+                if len(values) > 0 {
+                    values[0] = -1
+                }
+                _, _ = queue.Peek()
+            }
+        }()
+    }
+    wg.Wait()
+
+    require.Equal(t, 16*500, queue.Size(), "ConcurrentPriorityQueue size is not equal")
+    previous := -1
+    for !queue.IsEmpty() {
+        value, err := queue.Poll()
+        require.Nil(t, err, "ConcurrentPriorityQueue poll is failed")
+        require.Less(t, previous, value, "ConcurrentPriorityQueue is not min queue")
+        previous = value
+    }
+}
@@ -1,21 +1,39 @@
 package queue
 
-import "sync"
-
+import (
+    "context"
+    "go-utils/collection"
+    "sync"
+    "time"
+)
+
+var _ collection.Queue[int] = (*ConcurrentQueue[int])(nil)
+
+// ConcurrentQueue is an unbounded queue safe for concurrent use. Poll returns
+// at once, while Take waits for a value; use BlockingQueue to also bound the
+// size.
 type ConcurrentQueue[T comparable] struct {
-    mu    sync.RWMutex
-    queue *Queue[T]
+    mu      sync.RWMutex
+    queue   *Queue[T]
+    closed  bool
+    changed signal
 }
 
 func NewConcurrentQueue[T comparable]() *ConcurrentQueue[T] {
-    return &ConcurrentQueue[T]{queue: NewQueue[T]()}
+    return &ConcurrentQueue[T]{queue: NewQueue[T](), changed: newSignal()}
 }
 
 func (q *ConcurrentQueue[T]) Size() int {
+    q.mu.RLock()
+    defer q.mu.RUnlock()
+
     return q.queue.Size()
 }
 
 func (q *ConcurrentQueue[T]) IsEmpty() bool {
+    q.mu.RLock()
+    defer q.mu.RUnlock()
+
     return q.queue.IsEmpty()
 }
 
@@ -33,11 +51,19 @@ func (q *ConcurrentQueue[T]) Values() []T {
     return q.queue.Values()
 }
 
+func (q *ConcurrentQueue[T]) Contains(value T) bool {
+    q.mu.RLock()
+    defer q.mu.RUnlock()
+
+    return q.queue.Contains(value)
+}
+
 func (q *ConcurrentQueue[T]) Offer(value T) {
     q.mu.Lock()
     defer q.mu.Unlock()
 
     q.queue.Offer(value)
+    q.changed.broadcast()
 }
 
 func (q *ConcurrentQueue[T]) OfferValues(values []T) {
@@ -45,6 +71,7 @@ func (q *ConcurrentQueue[T]) OfferValues(values []T) {
     defer q.mu.Unlock()
 
     q.queue.AddAll(values)
+    q.changed.broadcast()
 }
 
 func (q *ConcurrentQueue[T]) Poll() (T, error) {
@@ -60,3 +87,55 @@ func (q *ConcurrentQueue[T]) Peek() (T, error) {
 
     return q.queue.Peek()
 }
+
+func (q *ConcurrentQueue[T]) Take() (T, error) {
+    return q.TakeContext(context.Background())
+}
+
+func (q *ConcurrentQueue[T]) PollTimeout(timeout time.Duration) (T, error) {
+    ctx, cancel := context.WithTimeout(context.Background(), timeout)
+    defer cancel()
+
+    return q.TakeContext(ctx)
+}
+
+// TakeContext waits until a value is available. Once the queue is closed and
+// empty it fails with ErrQueueClosed instead of waiting.
+func (q *ConcurrentQueue[T]) TakeContext(ctx context.Context) (T, error) {
+    q.mu.Lock()
+    for q.queue.IsEmpty() {
+        if q.closed {
+            q.mu.Unlock()
+            var zero T
+            return zero, ErrQueueClosed
+        }
+
+        if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+            var zero T
+            return zero, err
+        }
+    }
+    defer q.mu.Unlock()
+
+    return q.queue.Poll()
+}
+
+// Close wakes up every goroutine waiting in Take. The queue still accepts
+// values, but Take no longer waits once it is empty.
+func (q *ConcurrentQueue[T]) Close() {
+    q.mu.Lock()
+    defer q.mu.Unlock()
+
+    if q.closed {
+        return
+    }
+    q.closed = true
+    q.changed.broadcast()
+}
+
+func (q *ConcurrentQueue[T]) IsClosed() bool {
+    q.mu.RLock()
+    defer q.mu.RUnlock()
+
+    return q.closed
+}
@@ -1,6 +1,7 @@
 package queue
 
 import (
+    "context"
     "math/rand"
     "sync"
     "sync/atomic"
@@ -35,18 +36,27 @@ func TestConcurrentQueue(t *testing.T) {
     }
 
     // consume 100 items
+    var consumedMutex sync.Mutex
     consumed := make([]int, 0, 100)
     for c := 0; c < 10; c++ {
         wg.Add(1)
         go func() {
             defer wg.Done()
 
-            for len(consumed) < 100 {
+            for {
                 time.Sleep(time.Duration(100+rand.Intn(100)) * time.Millisecond)
+
+                consumedMutex.Lock()
+                if len(consumed) >= 100 {
+                    consumedMutex.Unlock()
+                    return
+                }
                 if !queue.IsEmpty() {
-                    value, _ := queue.Poll()
-                    consumed = append(consumed, value)
+                    if value, err := queue.Poll(); err == nil {
+                        consumed = append(consumed, value)
+                    }
                 }
+                consumedMutex.Unlock()
             }
         }()
     }
@@ -59,3 +69,65 @@ func TestConcurrentQueue(t *testing.T) {
         require.Equal(t, i, consumed[i], "ConcurrentQueue consumed item is not matched")
     }
 }
+
+func TestConcurrentQueue_Stress(t *testing.T) {
+    queue := NewConcurrentQueue[int]()
+
+    var wg sync.WaitGroup
+    var polled atomic.Int32
+    for w := 0; w < 16; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := 0; i < 500; i++ {
+                queue.Offer(w*500 + i)
+                _ = queue.Size()
+                _ = queue.IsEmpty()
+                _ = queue.Contains(i)
+                _ = queue.Values()
+                _, _ = queue.Peek()
+                if i%2 == 0 {
+                    if _, err := queue.Poll(); err == nil {
+                        polled.Add(1)
+                    }
+                }
+            }
+        }()
+    }
+    wg.Wait()
+
+    require.Equal(t, 16*500, queue.Size()+int(polled.Load()), "ConcurrentQueue size is not equal")
+}
+
+func TestConcurrentQueue_Take(t *testing.T) {
+    queue := NewConcurrentQueue[int]()
+
+    go func() {
+        time.Sleep(50 * time.Millisecond)
+        queue.Offer(10)
+    }()
+
+    start := time.Now()
+    value, err := queue.Take()
+    require.Nil(t, err, "ConcurrentQueue take is failed")
+    require.Equal(t, 10, value, "ConcurrentQueue take item is not matched")
+    require.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond, "ConcurrentQueue take did not block")
+
+    _, err = queue.PollTimeout(20 * time.Millisecond)
+    require.ErrorIs(t, err, context.DeadlineExceeded, "ConcurrentQueue poll timeout is not expired")
+
+    done := make(chan error)
+    go func() {
+        _, err := queue.Take()
+        done <- err
+    }()
+    time.Sleep(20 * time.Millisecond)
+    queue.Close()
+    require.ErrorIs(t, <-done, ErrQueueClosed, "ConcurrentQueue take is not woken up by close")
+    require.True(t, queue.IsClosed(), "ConcurrentQueue is not closed")
+
+    queue.Offer(1)
+    value, err = queue.Take()
+    require.Nil(t, err, "ConcurrentQueue take after close is failed")
+    require.Equal(t, 1, value, "ConcurrentQueue take after close item is not matched")
+}
@@ -0,0 +1,189 @@
+package queue
+
+import (
+	"context"
+	"go-utils/collection"
+	"sync"
+	"time"
+)
+
+var _ collection.Collection[int] = (*ConcurrentRingBuffer[int])(nil)
+
+// ConcurrentRingBuffer is a thread-safe RingBuffer for any number of producers
+// and consumers, so it serves both SPSC and MPSC pipelines. Under the Block
+// policy Put waits for space; Take always waits for a value.
+type ConcurrentRingBuffer[T comparable] struct {
+	mu      sync.Mutex
+	buffer  *RingBuffer[T]
+	closed  bool
+	changed signal
+}
+
+func NewConcurrentRingBuffer[T comparable](capacity int, policy FullPolicy) *ConcurrentRingBuffer[T] {
+	return &ConcurrentRingBuffer[T]{
+		buffer:  NewRingBuffer[T](capacity, policy),
+		changed: newSignal(),
+	}
+}
+
+func (q *ConcurrentRingBuffer[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Size()
+}
+
+func (q *ConcurrentRingBuffer[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+func (q *ConcurrentRingBuffer[T]) Capacity() int {
+	return q.buffer.Capacity()
+}
+
+// Values returns a snapshot from oldest to newest.
+func (q *ConcurrentRingBuffer[T]) Values() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Values()
+}
+
+func (q *ConcurrentRingBuffer[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.buffer.Clear()
+	q.changed.broadcast()
+}
+
+func (q *ConcurrentRingBuffer[T]) Contains(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Contains(value)
+}
+
+// Latest returns a snapshot of the n most recent values, oldest first.
+func (q *ConcurrentRingBuffer[T]) Latest(n int) []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Latest(n)
+}
+
+// Offer adds the value without waiting. When full it evicts the oldest value
+// under the Overwrite policy and otherwise returns false.
+func (q *ConcurrentRingBuffer[T]) Offer(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || !q.buffer.Offer(value) {
+		return false
+	}
+
+	q.changed.broadcast()
+	return true
+}
+
+func (q *ConcurrentRingBuffer[T]) Put(value T) error {
+	return q.PutContext(context.Background(), value)
+}
+
+// PutContext adds the value according to the policy: Overwrite never waits,
+// Reject fails with ErrBufferFull and Block waits for space. It fails with
+// ErrQueueClosed once the buffer is closed, or with the context's error.
+func (q *ConcurrentRingBuffer[T]) PutContext(ctx context.Context, value T) error {
+	q.mu.Lock()
+	for {
+		if q.closed {
+			q.mu.Unlock()
+			return ErrQueueClosed
+		}
+		if !q.buffer.IsFull() || q.buffer.policy == Overwrite {
+			break
+		}
+		if q.buffer.policy == Reject {
+			q.mu.Unlock()
+			return ErrBufferFull
+		}
+
+		if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+			return err
+		}
+	}
+	defer q.mu.Unlock()
+
+	q.buffer.Offer(value)
+	q.changed.broadcast()
+	return nil
+}
+
+// Poll removes the oldest value without waiting.
+func (q *ConcurrentRingBuffer[T]) Poll() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	value, err := q.buffer.Poll()
+	if err == nil {
+		q.changed.broadcast()
+	}
+	return value, err
+}
+
+func (q *ConcurrentRingBuffer[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.buffer.Peek()
+}
+
+func (q *ConcurrentRingBuffer[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+func (q *ConcurrentRingBuffer[T]) PollTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.TakeContext(ctx)
+}
+
+// TakeContext waits until a value is available. Values offered before Close
+// can still be taken; after that it fails with ErrQueueClosed.
+func (q *ConcurrentRingBuffer[T]) TakeContext(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for q.buffer.IsEmpty() {
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+
+		if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	defer q.mu.Unlock()
+
+	value, _ := q.buffer.Poll()
+	q.changed.broadcast()
+	return value, nil
+}
+
+// Close rejects further puts and wakes up every waiting goroutine.
+func (q *ConcurrentRingBuffer[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.closed = true
+	q.changed.broadcast()
+}
+
+func (q *ConcurrentRingBuffer[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrentRingBuffer_Policies(t *testing.T) {
+	overwrite := NewConcurrentRingBuffer[int](2, Overwrite)
+	for i := 1; i <= 3; i++ {
+		require.Nil(t, overwrite.Put(i), "ConcurrentRingBuffer put is failed")
+	}
+	require.Equal(t, []int{2, 3}, overwrite.Values(), "ConcurrentRingBuffer values are not equal")
+	require.Equal(t, []int{3}, overwrite.Latest(1), "ConcurrentRingBuffer latest(1) is not matched")
+
+	reject := NewConcurrentRingBuffer[int](1, Reject)
+	require.True(t, reject.Offer(1), "ConcurrentRingBuffer offer is failed")
+	require.False(t, reject.Offer(2), "ConcurrentRingBuffer offer over capacity is not rejected")
+	require.ErrorIs(t, reject.Put(2), ErrBufferFull, "ConcurrentRingBuffer put over capacity is not rejected")
+
+	block := NewConcurrentRingBuffer[int](1, Block)
+	require.Nil(t, block.Put(1), "ConcurrentRingBuffer put is failed")
+	require.False(t, block.Offer(2), "ConcurrentRingBuffer offer over capacity is not rejected")
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, block.PutContext(ctx, 2), context.DeadlineExceeded, "ConcurrentRingBuffer put is not blocked")
+}
+
+func TestConcurrentRingBuffer_PutWaitsForTake(t *testing.T) {
+	buffer := NewConcurrentRingBuffer[int](1, Block)
+	require.Nil(t, buffer.Put(1), "ConcurrentRingBuffer put is failed")
+
+	done := make(chan error)
+	go func() {
+		done <- buffer.Put(2)
+	}()
+
+	select {
+	case <-done:
+		require.Fail(t, "ConcurrentRingBuffer put did not block on full buffer")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	value, _ := buffer.Take()
+	require.Equal(t, 1, value, "ConcurrentRingBuffer take item is not matched")
+	require.Nil(t, <-done, "ConcurrentRingBuffer put is failed")
+	require.Equal(t, []int{2}, buffer.Values(), "ConcurrentRingBuffer values are not equal")
+}
+
+func TestConcurrentRingBuffer_Close(t *testing.T) {
+	buffer := NewConcurrentRingBuffer[int](2, Block)
+	require.Nil(t, buffer.Put(1), "ConcurrentRingBuffer put is failed")
+
+	done := make(chan error)
+	go func() {
+		_, _ = buffer.Take()
+		_, err := buffer.Take()
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	buffer.Close()
+	require.True(t, buffer.IsClosed(), "ConcurrentRingBuffer is not closed")
+	require.ErrorIs(t, <-done, ErrQueueClosed, "ConcurrentRingBuffer take after close is not failed")
+	require.ErrorIs(t, buffer.Put(3), ErrQueueClosed, "ConcurrentRingBuffer put after close is not failed")
+	require.False(t, buffer.Offer(3), "ConcurrentRingBuffer offer after close is not rejected")
+}
+
+func TestConcurrentRingBuffer_MPSC(t *testing.T) {
+	buffer := NewConcurrentRingBuffer[int](16, Block)
+	producers, perProducer := 8, 500
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				_ = buffer.Put(p*perProducer + i)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		buffer.Close()
+	}()
+
+	consumed := []int{}
+	for {
+		value, err := buffer.Take()
+		if err != nil {
+			require.ErrorIs(t, err, ErrQueueClosed, "ConcurrentRingBuffer take is failed")
+			break
+		}
+		consumed = append(consumed, value)
+	}
+
+	sort.Ints(consumed)
+	require.Len(t, consumed, producers*perProducer, "ConcurrentRingBuffer consumed count is not equal")
+	for i, value := range consumed {
+		require.Equal(t, i, value, "ConcurrentRingBuffer consumed value is not matched")
+	}
+}
@@ -0,0 +1,216 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"go-utils/collection"
+	"sync"
+	"time"
+)
+
+var _ collection.Collection[int] = (*DelayQueue[int])(nil)
+
+// ErrNotExpired is returned by DelayQueue.Poll when the queue holds values but
+// none of their deadlines has passed yet.
+var ErrNotExpired = errors.New("queue has no expired value")
+
+type delayed[T comparable] struct {
+	value    T
+	deadline time.Time
+}
+
+// DelayQueue holds values until their deadline passes. Take waits for the
+// earliest deadline and re-evaluates whenever an earlier value is offered.
+type DelayQueue[T comparable] struct {
+	mu      sync.Mutex
+	queue   *PriorityQueue[delayed[T]]
+	closed  bool
+	changed signal
+}
+
+func NewDelayQueue[T comparable]() *DelayQueue[T] {
+	return &DelayQueue[T]{
+		queue: NewPriorityQueue[delayed[T]](func(a, b delayed[T]) int {
+			return a.deadline.Compare(b.deadline)
+		}),
+		changed: newSignal(),
+	}
+}
+
+// Size counts every value, whether or not its deadline has passed.
+func (q *DelayQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+func (q *DelayQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Values returns every value ordered by deadline.
+func (q *DelayQueue[T]) Values() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	values := make([]T, 0, q.queue.Size())
+	for item := range q.queue.All() {
+		values = append(values, item.value)
+	}
+	return values
+}
+
+func (q *DelayQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+	q.changed.broadcast()
+}
+
+func (q *DelayQueue[T]) Contains(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, item := range q.queue.Values() {
+		if item.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Offer schedules the value to become available at deadline and reports
+// whether it was added; it only fails once the queue is closed.
+func (q *DelayQueue[T]) Offer(value T, deadline time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	q.queue.Offer(delayed[T]{value: value, deadline: deadline})
+	q.changed.broadcast()
+	return true
+}
+
+func (q *DelayQueue[T]) OfferDelay(value T, delay time.Duration) bool {
+	return q.Offer(value, time.Now().Add(delay))
+}
+
+// Peek returns the value with the earliest deadline even if it has not expired.
+func (q *DelayQueue[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	item, err := q.queue.Peek()
+	return item.value, err
+}
+
+// Poll removes the value with the earliest deadline if that deadline has passed.
+func (q *DelayQueue[T]) Poll() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if item, ok := q.pollExpired(); ok {
+		return item.value, nil
+	}
+
+	var zero T
+	if q.queue.IsEmpty() {
+		return zero, collection.ErrEmpty
+	}
+	return zero, ErrNotExpired
+}
+
+func (q *DelayQueue[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+func (q *DelayQueue[T]) PollTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.TakeContext(ctx)
+}
+
+// TakeContext waits until the earliest deadline passes and removes that value.
+// Once the queue is closed it only returns values that have already expired
+// and fails with ErrQueueClosed otherwise.
+func (q *DelayQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	var zero T
+
+	q.mu.Lock()
+	for {
+		if item, ok := q.pollExpired(); ok {
+			q.mu.Unlock()
+			return item.value, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return zero, ErrQueueClosed
+		}
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if head, err := q.queue.Peek(); err == nil {
+			timer = time.NewTimer(time.Until(head.deadline))
+			timeout = timer.C
+		}
+
+		err := q.changed.wait(ctx, &q.mu, timeout)
+		if timer != nil {
+			timer.Stop()
+		}
+		if err != nil {
+			return zero, err
+		}
+	}
+}
+
+// DrainTo moves every expired value into target and returns how many were moved.
+func (q *DelayQueue[T]) DrainTo(target collection.Queue[T]) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	count := 0
+	for {
+		item, ok := q.pollExpired()
+		if !ok {
+			return count
+		}
+		target.Offer(item.value)
+		count++
+	}
+}
+
+// Close rejects further offers and wakes up every waiting goroutine.
+func (q *DelayQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.changed.broadcast()
+}
+
+func (q *DelayQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
+
+func (q *DelayQueue[T]) pollExpired() (delayed[T], bool) {
+	head, err := q.queue.Peek()
+	if err != nil || head.deadline.After(time.Now()) {
+		return head, false
+	}
+
+	q.queue.Poll()
+	return head, true
+}
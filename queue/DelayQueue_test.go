@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelayQueue(t *testing.T) {
+	queue := NewDelayQueue[string]()
+	now := time.Now()
+	queue.Offer("late", now.Add(80*time.Millisecond))
+	queue.Offer("expired", now.Add(-time.Millisecond))
+	queue.Offer("soon", now.Add(40*time.Millisecond))
+
+	require.Equal(t, 3, queue.Size(), "DelayQueue size is not equal")
+	require.Equal(t, []string{"expired", "soon", "late"}, queue.Values(), "DelayQueue values are not equal")
+	require.True(t, queue.Contains("soon"), "DelayQueue contains(soon) is not matched")
+
+	value, err := queue.Poll()
+	require.Nil(t, err, "DelayQueue poll is failed")
+	require.Equal(t, "expired", value, "DelayQueue poll item is not matched")
+
+	_, err = queue.Poll()
+	require.NotNil(t, err, "DelayQueue poll before deadline is not failed")
+	value, err = queue.Peek()
+	require.Nil(t, err, "DelayQueue peek is failed")
+	require.Equal(t, "soon", value, "DelayQueue peek item is not matched")
+
+	value, err = queue.Take()
+	require.Nil(t, err, "DelayQueue take is failed")
+	require.Equal(t, "soon", value, "DelayQueue take item is not matched")
+	require.False(t, time.Now().Before(now.Add(40*time.Millisecond)), "DelayQueue take returned before deadline")
+
+	value, err = queue.Take()
+	require.Nil(t, err, "DelayQueue take is failed")
+	require.Equal(t, "late", value, "DelayQueue take item is not matched")
+	require.False(t, time.Now().Before(now.Add(80*time.Millisecond)), "DelayQueue take returned before deadline")
+	require.True(t, queue.IsEmpty(), "DelayQueue is not empty")
+}
+
+func TestDelayQueue_EarlierOfferWakesTake(t *testing.T) {
+	queue := NewDelayQueue[int]()
+	queue.OfferDelay(1, time.Hour)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		queue.OfferDelay(2, 10*time.Millisecond)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	value, err := queue.TakeContext(ctx)
+	require.Nil(t, err, "DelayQueue take is failed")
+	require.Equal(t, 2, value, "DelayQueue take item is not matched")
+	require.Equal(t, 1, queue.Size(), "DelayQueue size is not equal")
+}
+
+func TestDelayQueue_TimeoutAndClose(t *testing.T) {
+	queue := NewDelayQueue[int]()
+	queue.OfferDelay(1, time.Hour)
+
+	_, err := queue.PollTimeout(20 * time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "DelayQueue poll timeout is not expired")
+
+	target := NewQueue[int]()
+	queue.OfferDelay(2, -time.Millisecond)
+	queue.OfferDelay(3, -time.Millisecond)
+	require.Equal(t, 2, queue.DrainTo(target), "DelayQueue drain count is not equal")
+	require.ElementsMatch(t, []int{2, 3}, target.Values(), "DelayQueue drained values are not equal")
+
+	done := make(chan error)
+	go func() {
+		_, err := queue.Take()
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	queue.Close()
+	require.ErrorIs(t, <-done, ErrQueueClosed, "DelayQueue take is not woken up by close")
+	require.False(t, queue.OfferDelay(4, 0), "DelayQueue offer after close is not rejected")
+}
@@ -0,0 +1,48 @@
+package queue
+
+// heapStorage is the array behind a binary heap. The sift functions reorder it
+// only through compare and swap, so an implementation can keep track of where
+// its items move.
+type heapStorage interface {
+	compare(i, j int) int
+	swap(i, j int)
+}
+
+// siftUp moves the item at pos towards the root until its parent is not
+// larger, and returns its final position.
+func siftUp(heap heapStorage, pos int) int {
+	for pos > 0 {
+		top := (pos - 1) / 2
+		if heap.compare(top, pos) <= 0 {
+			break
+		}
+
+		heap.swap(top, pos)
+		pos = top
+	}
+	return pos
+}
+
+// siftDown moves the item at index towards the leaves of a heap of the given
+// length until no child is smaller, and returns its final position.
+func siftDown(heap heapStorage, index, length int) int {
+	for {
+		left := index*2 + 1
+		right := index*2 + 2
+		smallest := index
+
+		if left < length && heap.compare(left, smallest) <= 0 {
+			smallest = left
+		}
+		if right < length && heap.compare(right, smallest) <= 0 {
+			smallest = right
+		}
+
+		if smallest == index {
+			return index
+		}
+
+		heap.swap(smallest, index)
+		index = smallest
+	}
+}
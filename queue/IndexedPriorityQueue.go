@@ -0,0 +1,193 @@
+package queue
+
+import (
+	"fmt"
+	"go-utils/collection"
+	"iter"
+)
+
+// Handle refers to an entry of an IndexedPriorityQueue and stays valid until
+// the entry is polled or removed.
+type Handle[K comparable, V any] struct {
+	key   K
+	value V
+	index int
+}
+
+func (h *Handle[K, V]) Key() K {
+	return h.key
+}
+
+func (h *Handle[K, V]) Value() V {
+	return h.value
+}
+
+// IndexedPriorityQueue is a priority queue of keys ordered by their values,
+// with at most one entry per key. Besides Offer and Poll it can change the
+// value of an entry or remove it in O(log n), through the Handle returned by
+// Offer or looked up by key. It shares the sift code of PriorityQueue.
+type IndexedPriorityQueue[K comparable, V any] struct {
+	items      []*Handle[K, V]
+	keys       map[K]*Handle[K, V]
+	comparator func(a, b V) int
+}
+
+func NewIndexedPriorityQueue[K comparable, V any](comparator func(a, b V) int) *IndexedPriorityQueue[K, V] {
+	return &IndexedPriorityQueue[K, V]{
+		keys:       map[K]*Handle[K, V]{},
+		comparator: comparator,
+	}
+}
+
+func (q *IndexedPriorityQueue[K, V]) Size() int {
+	return len(q.items)
+}
+
+func (q *IndexedPriorityQueue[K, V]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+func (q *IndexedPriorityQueue[K, V]) Clear() {
+	for _, item := range q.items {
+		item.index = -1
+	}
+	q.items = nil
+	q.keys = map[K]*Handle[K, V]{}
+}
+
+func (q *IndexedPriorityQueue[K, V]) Contains(key K) bool {
+	_, ok := q.keys[key]
+	return ok
+}
+
+// Handle returns the handle of the entry for key.
+func (q *IndexedPriorityQueue[K, V]) Handle(key K) (*Handle[K, V], bool) {
+	handle, ok := q.keys[key]
+	return handle, ok
+}
+
+// Offer adds the key with the given value, or updates the value if the key is
+// already queued, and returns its handle.
+func (q *IndexedPriorityQueue[K, V]) Offer(key K, value V) *Handle[K, V] {
+	if handle, ok := q.keys[key]; ok {
+		q.Update(handle, value)
+		return handle
+	}
+
+	handle := &Handle[K, V]{key: key, value: value, index: len(q.items)}
+	q.items = append(q.items, handle)
+	q.keys[key] = handle
+	siftUp(q, handle.index)
+	return handle
+}
+
+// Update changes the value of the entry and restores the heap order. It
+// returns false if the handle is no longer in the queue.
+func (q *IndexedPriorityQueue[K, V]) Update(handle *Handle[K, V], value V) bool {
+	if !q.owns(handle) {
+		return false
+	}
+
+	handle.value = value
+	q.fix(handle.index)
+	return true
+}
+
+// DecreaseKey lowers the value of the entry. It returns
+// collection.ErrInvalidHandle if the handle is no longer in the queue, and an
+// error wrapping collection.ErrInvalidArgument if value would move the entry
+// back.
+func (q *IndexedPriorityQueue[K, V]) DecreaseKey(handle *Handle[K, V], value V) error {
+	if !q.owns(handle) {
+		return collection.ErrInvalidHandle
+	}
+	if q.comparator(value, handle.value) > 0 {
+		return fmt.Errorf("value is greater than the current value: %w", collection.ErrInvalidArgument)
+	}
+
+	handle.value = value
+	siftUp(q, handle.index)
+	return nil
+}
+
+// Remove removes the entry and reports whether it was still in the queue.
+func (q *IndexedPriorityQueue[K, V]) Remove(handle *Handle[K, V]) bool {
+	if !q.owns(handle) {
+		return false
+	}
+
+	q.removeAt(handle.index)
+	return true
+}
+
+func (q *IndexedPriorityQueue[K, V]) Peek() (K, V, error) {
+	if q.IsEmpty() {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, collection.ErrEmpty
+	}
+
+	top := q.items[0]
+	return top.key, top.value, nil
+}
+
+func (q *IndexedPriorityQueue[K, V]) Poll() (K, V, error) {
+	if q.IsEmpty() {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, collection.ErrEmpty
+	}
+
+	top := q.items[0]
+	q.removeAt(0)
+	return top.key, top.value, nil
+}
+
+// Entries yields the keys and values in heap order, not priority order.
+func (q *IndexedPriorityQueue[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, item := range q.items {
+			if !yield(item.key, item.value) {
+				return
+			}
+		}
+	}
+}
+
+func (q *IndexedPriorityQueue[K, V]) owns(handle *Handle[K, V]) bool {
+	return handle != nil && handle.index >= 0 && handle.index < len(q.items) && q.items[handle.index] == handle
+}
+
+func (q *IndexedPriorityQueue[K, V]) removeAt(index int) {
+	removed := q.items[index]
+	last := len(q.items) - 1
+	if index != last {
+		q.swap(index, last)
+	}
+	q.items[last] = nil
+	q.items = q.items[:last]
+
+	delete(q.keys, removed.key)
+	removed.index = -1
+
+	if index < len(q.items) {
+		q.fix(index)
+	}
+}
+
+// fix moves the item at index up or down to its place in the heap.
+func (q *IndexedPriorityQueue[K, V]) fix(index int) {
+	if siftUp(q, index) == index {
+		siftDown(q, index, len(q.items))
+	}
+}
+
+func (q *IndexedPriorityQueue[K, V]) compare(i, j int) int {
+	return q.comparator(q.items[i].value, q.items[j].value)
+}
+
+func (q *IndexedPriorityQueue[K, V]) swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	q.items[i].index = i
+	q.items[j].index = j
+}
@@ -0,0 +1,140 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func checkIndexedHeap[K comparable, V any](t *testing.T, queue *IndexedPriorityQueue[K, V]) {
+	require.Equal(t, len(queue.items), len(queue.keys), "IndexedPriorityQueue key index size is not equal")
+	for i, item := range queue.items {
+		require.Equal(t, i, item.index, "IndexedPriorityQueue handle index is not matched")
+		require.Same(t, item, queue.keys[item.key], "IndexedPriorityQueue key index is not matched")
+		if i > 0 {
+			require.LessOrEqual(t, queue.compare((i-1)/2, i), 0, "IndexedPriorityQueue heap order is broken")
+		}
+	}
+}
+
+func TestIndexedPriorityQueue(t *testing.T) {
+	queue := NewIndexedPriorityQueue[string, int](func(a, b int) int { return a - b })
+	_, _, err := queue.Poll()
+	require.NotNil(t, err, "IndexedPriorityQueue poll is not failed")
+
+	a := queue.Offer("a", 5)
+	b := queue.Offer("b", 3)
+	c := queue.Offer("c", 8)
+	queue.Offer("d", 1)
+	require.Equal(t, 4, queue.Size(), "IndexedPriorityQueue size is not equal")
+	require.True(t, queue.Contains("c"), "IndexedPriorityQueue contains(c) is not matched")
+
+	key, value, err := queue.Peek()
+	require.Nil(t, err, "IndexedPriorityQueue peek is failed")
+	require.Equal(t, "d", key, "IndexedPriorityQueue peek key is not matched")
+	require.Equal(t, 1, value, "IndexedPriorityQueue peek value is not matched")
+
+	require.Nil(t, queue.DecreaseKey(c, 0), "IndexedPriorityQueue decrease key is failed")
+	require.ErrorIs(t, queue.DecreaseKey(a, 10), collection.ErrInvalidArgument, "IndexedPriorityQueue decrease key to a larger value is not failed")
+	key, _, _ = queue.Peek()
+	require.Equal(t, "c", key, "IndexedPriorityQueue peek after decrease key is not matched")
+
+	require.True(t, queue.Update(c, 20), "IndexedPriorityQueue update is failed")
+	require.Equal(t, 20, c.Value(), "IndexedPriorityQueue handle value is not matched")
+	require.Same(t, b, queue.Offer("b", 30), "IndexedPriorityQueue offer of a queued key returns a new handle")
+	checkIndexedHeap(t, queue)
+
+	require.True(t, queue.Remove(a), "IndexedPriorityQueue remove(a) is failed")
+	require.False(t, queue.Remove(a), "IndexedPriorityQueue remove(a) twice is not failed")
+	require.False(t, queue.Update(a, 1), "IndexedPriorityQueue update of a removed handle is not failed")
+	require.ErrorIs(t, queue.DecreaseKey(a, 0), collection.ErrInvalidHandle, "IndexedPriorityQueue decrease key of a removed handle is not failed")
+	require.False(t, queue.Contains("a"), "IndexedPriorityQueue contains(a) is not matched")
+
+	polled := []string{}
+	for !queue.IsEmpty() {
+		key, _, _ := queue.Poll()
+		polled = append(polled, key)
+	}
+	require.Equal(t, []string{"d", "c", "b"}, polled, "IndexedPriorityQueue poll order is not matched")
+
+	handle, ok := queue.Handle("b")
+	require.False(t, ok, "IndexedPriorityQueue handle(b) is not removed")
+	require.Nil(t, handle, "IndexedPriorityQueue handle(b) is not nil")
+}
+
+func TestIndexedPriorityQueue_Dijkstra(t *testing.T) {
+	graph := map[string]map[string]int{
+		"a": {"b": 7, "c": 9, "f": 14},
+		"b": {"a": 7, "c": 10, "d": 15},
+		"c": {"a": 9, "b": 10, "d": 11, "f": 2},
+		"d": {"b": 15, "c": 11, "e": 6},
+		"e": {"d": 6, "f": 9},
+		"f": {"a": 14, "c": 2, "e": 9},
+	}
+
+	distances := map[string]int{}
+	queue := NewIndexedPriorityQueue[string, int](func(a, b int) int { return a - b })
+	queue.Offer("a", 0)
+	for !queue.IsEmpty() {
+		node, distance, _ := queue.Poll()
+		distances[node] = distance
+		for next, weight := range graph[node] {
+			if _, done := distances[next]; done {
+				continue
+			}
+
+			if handle, ok := queue.Handle(next); !ok {
+				queue.Offer(next, distance+weight)
+			} else if distance+weight < handle.Value() {
+				require.Nil(t, queue.DecreaseKey(handle, distance+weight), "IndexedPriorityQueue decrease key is failed")
+			}
+		}
+	}
+
+	require.Equal(t, map[string]int{"a": 0, "b": 7, "c": 9, "d": 20, "e": 20, "f": 11}, distances, "Dijkstra distances are not matched")
+}
+
+func TestIndexedPriorityQueue_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	queue := NewIndexedPriorityQueue[int, int](func(a, b int) int { return a - b })
+	expected := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(200)
+		switch random.Intn(4) {
+		case 0:
+			if handle, ok := queue.Handle(key); ok {
+				require.True(t, queue.Remove(handle), "IndexedPriorityQueue remove is failed")
+				delete(expected, key)
+			}
+		case 1:
+			if !queue.IsEmpty() {
+				key, value, _ := queue.Poll()
+				for _, other := range expected {
+					require.LessOrEqual(t, value, other, "IndexedPriorityQueue poll is not the minimum")
+				}
+				require.Equal(t, expected[key], value, "IndexedPriorityQueue poll value is not matched")
+				delete(expected, key)
+			}
+		default:
+			value := random.Intn(1000)
+			queue.Offer(key, value)
+			expected[key] = value
+		}
+
+		if i%250 == 0 {
+			checkIndexedHeap(t, queue)
+		}
+	}
+
+	checkIndexedHeap(t, queue)
+	require.Equal(t, len(expected), queue.Size(), "IndexedPriorityQueue size is not equal")
+	for key, value := range queue.Entries() {
+		require.Equal(t, expected[key], value, "IndexedPriorityQueue entry is not matched")
+	}
+
+	queue.Clear()
+	require.True(t, queue.IsEmpty(), "IndexedPriorityQueue is not empty")
+}
@@ -0,0 +1,132 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"sync/atomic"
+)
+
+var _ collection.Queue[int] = (*LockFreeQueue[int])(nil)
+
+type lockFreeNode[T comparable] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// LockFreeQueue is a Michael-Scott queue: Offer and Poll use compare-and-swap
+// on the tail and head pointers instead of a mutex. head always points at a
+// sentinel node whose successor is the front of the queue.
+//
+// Size, Values and Contains are weakly consistent while other goroutines are
+// modifying the queue.
+type LockFreeQueue[T comparable] struct {
+	head atomic.Pointer[lockFreeNode[T]]
+	tail atomic.Pointer[lockFreeNode[T]]
+	size atomic.Int64
+}
+
+func NewLockFreeQueue[T comparable]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	sentinel := &lockFreeNode[T]{}
+	q.head.Store(sentinel)
+	q.tail.Store(sentinel)
+	return q
+}
+
+func (q *LockFreeQueue[T]) Size() int {
+	return int(max(q.size.Load(), 0))
+}
+
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+func (q *LockFreeQueue[T]) Values() []T {
+	values := []T{}
+	for node := q.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+func (q *LockFreeQueue[T]) Contains(value T) bool {
+	for node := q.head.Load().next.Load(); node != nil; node = node.next.Load() {
+		if node.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear polls until the queue is empty.
+func (q *LockFreeQueue[T]) Clear() {
+	for {
+		if _, err := q.Poll(); err != nil {
+			return
+		}
+	}
+}
+
+func (q *LockFreeQueue[T]) Offer(value T) {
+	node := &lockFreeNode[T]{value: value}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+
+		if next != nil {
+			// tail is lagging behind, help the other goroutine move it
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+func (q *LockFreeQueue[T]) OfferValues(values []T) {
+	for _, value := range values {
+		q.Offer(value)
+	}
+}
+
+func (q *LockFreeQueue[T]) Poll() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+
+		if next == nil {
+			var zero T
+			return zero, collection.ErrEmpty
+		}
+
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+
+		// next becomes the new sentinel
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return next.value, nil
+		}
+	}
+}
+
+func (q *LockFreeQueue[T]) Peek() (T, error) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return next.value, nil
+}
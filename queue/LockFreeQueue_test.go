@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFreeQueue(t *testing.T) {
+	queue := NewLockFreeQueue[int]()
+	require.True(t, queue.IsEmpty(), "LockFreeQueue is not empty")
+	_, err := queue.Poll()
+	require.NotNil(t, err, "LockFreeQueue poll on empty is not failed")
+	_, err = queue.Peek()
+	require.NotNil(t, err, "LockFreeQueue peek on empty is not failed")
+
+	queue.OfferValues([]int{1, 2, 3})
+	queue.Offer(4)
+	require.Equal(t, 4, queue.Size(), "LockFreeQueue size is not equal")
+	require.Equal(t, []int{1, 2, 3, 4}, queue.Values(), "LockFreeQueue values are not equal")
+	require.True(t, queue.Contains(3), "LockFreeQueue contains(3) is not matched")
+
+	for i := 1; i <= 4; i++ {
+		value, err := queue.Peek()
+		require.Nil(t, err, "LockFreeQueue peek is failed")
+		require.Equal(t, i, value, "LockFreeQueue peek item is not matched")
+		value, err = queue.Poll()
+		require.Nil(t, err, "LockFreeQueue poll is failed")
+		require.Equal(t, i, value, "LockFreeQueue poll item is not matched")
+	}
+	require.True(t, queue.IsEmpty(), "LockFreeQueue is not empty")
+
+	queue.OfferValues([]int{5, 6})
+	queue.Clear()
+	require.True(t, queue.IsEmpty(), "LockFreeQueue is not empty")
+	require.Equal(t, 0, queue.Size(), "LockFreeQueue size is not equal")
+}
+
+func TestLockFreeQueue_Stress(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 2000
+	queue := NewLockFreeQueue[int]()
+
+	var producerWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerWg.Add(1)
+		go func() {
+			defer producerWg.Done()
+			for i := 0; i < perProducer; i++ {
+				queue.Offer(p*perProducer + i)
+			}
+		}()
+	}
+
+	var mutex sync.Mutex
+	var consumerWg sync.WaitGroup
+	consumed := make([]int, 0, producers*perProducer)
+	done := make(chan struct{})
+	for c := 0; c < consumers; c++ {
+		consumerWg.Add(1)
+		go func() {
+			defer consumerWg.Done()
+			local := []int{}
+			// per-producer values must come out in the order they were offered
+			last := make([]int, producers)
+			for i := range last {
+				last[i] = -1
+			}
+			for {
+				value, err := queue.Poll()
+				if err != nil {
+					select {
+					case <-done:
+						if queue.IsEmpty() {
+							mutex.Lock()
+							consumed = append(consumed, local...)
+							mutex.Unlock()
+							return
+						}
+					default:
+					}
+					continue
+				}
+
+				p, i := value/perProducer, value%perProducer
+				assert.Greater(t, i, last[p], "LockFreeQueue broke FIFO order")
+				last[p] = i
+				local = append(local, value)
+			}
+		}()
+	}
+
+	producerWg.Wait()
+	close(done)
+	consumerWg.Wait()
+
+	sort.Ints(consumed)
+	require.Equal(t, producers*perProducer, len(consumed), "LockFreeQueue consumed size is not equal")
+	for i, value := range consumed {
+		require.Equal(t, i, value, "LockFreeQueue consumed item is not matched")
+	}
+	require.Equal(t, 0, queue.Size(), "LockFreeQueue size is not equal")
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	queue := NewLockFreeQueue[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Offer(i)
+			_, _ = queue.Poll()
+		}
+	})
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	queue := NewConcurrentQueue[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			queue.Offer(i)
+			_, _ = queue.Poll()
+		}
+	})
+}
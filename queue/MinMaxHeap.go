@@ -0,0 +1,276 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"iter"
+	"math/bits"
+	"slices"
+)
+
+var _ collection.Queue[int] = (*MinMaxHeap[int])(nil)
+
+// MinMaxHeap is a double-ended priority queue: both the smallest and the
+// largest value can be peeked in O(1) and polled in O(log n). Poll and Peek
+// work on the smallest value, like PriorityQueue.
+//
+// Even levels of the heap are ordered like a min-heap and odd levels like a
+// max-heap, so the largest value is always one of the children of the root.
+//
+// A bounded heap keeps at most capacity values and evicts the largest one
+// when it overflows. To keep the K largest values of a stream, bound the heap
+// to K with a reversed comparator.
+type MinMaxHeap[T comparable] struct {
+	items      []T
+	capacity   int
+	comparator func(a, b T) int
+}
+
+func NewMinMaxHeap[T comparable](comparator func(a, b T) int) *MinMaxHeap[T] {
+	return &MinMaxHeap[T]{comparator: comparator}
+}
+
+// NewBoundedMinMaxHeap creates a heap holding up to capacity values, at least one.
+func NewBoundedMinMaxHeap[T comparable](capacity int, comparator func(a, b T) int) *MinMaxHeap[T] {
+	capacity = max(capacity, 1)
+	return &MinMaxHeap[T]{items: make([]T, 0, capacity), capacity: capacity, comparator: comparator}
+}
+
+func (q *MinMaxHeap[T]) Size() int {
+	return len(q.items)
+}
+
+func (q *MinMaxHeap[T]) IsEmpty() bool {
+	return len(q.items) == 0
+}
+
+// Capacity returns the bound of the heap, or 0 if it is unbounded.
+func (q *MinMaxHeap[T]) Capacity() int {
+	return q.capacity
+}
+
+func (q *MinMaxHeap[T]) IsFull() bool {
+	return q.capacity > 0 && len(q.items) >= q.capacity
+}
+
+// Values returns the values in heap order, not priority order.
+func (q *MinMaxHeap[T]) Values() []T {
+	return slices.Clone(q.items)
+}
+
+func (q *MinMaxHeap[T]) Clear() {
+	q.items = make([]T, 0, q.capacity)
+}
+
+func (q *MinMaxHeap[T]) Contains(value T) bool {
+	return slices.Contains(q.items, value)
+}
+
+func (q *MinMaxHeap[T]) Offer(value T) {
+	q.OfferEvict(value)
+}
+
+// OfferEvict adds the value and, if that overflows a bounded heap, evicts and
+// returns the largest value, which may be the offered one.
+func (q *MinMaxHeap[T]) OfferEvict(value T) (T, bool) {
+	if q.IsFull() {
+		largest := q.maxIndex()
+		if q.comparator(value, q.items[largest]) >= 0 {
+			return value, true
+		}
+
+		evicted := q.items[largest]
+		q.removeAt(largest)
+		q.push(value)
+		return evicted, true
+	}
+
+	q.push(value)
+	var zero T
+	return zero, false
+}
+
+func (q *MinMaxHeap[T]) OfferValues(values []T) {
+	for _, value := range values {
+		q.Offer(value)
+	}
+}
+
+func (q *MinMaxHeap[T]) Peek() (T, error) {
+	return q.PeekMin()
+}
+
+func (q *MinMaxHeap[T]) Poll() (T, error) {
+	return q.PollMin()
+}
+
+func (q *MinMaxHeap[T]) PeekMin() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.items[0], nil
+}
+
+func (q *MinMaxHeap[T]) PeekMax() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.items[q.maxIndex()], nil
+}
+
+func (q *MinMaxHeap[T]) PollMin() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	value := q.items[0]
+	q.removeAt(0)
+	return value, nil
+}
+
+func (q *MinMaxHeap[T]) PollMax() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	index := q.maxIndex()
+	value := q.items[index]
+	q.removeAt(index)
+	return value, nil
+}
+
+// All yields the values from smallest to largest without modifying the heap.
+func (q *MinMaxHeap[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		clone := &MinMaxHeap[T]{items: slices.Clone(q.items), comparator: q.comparator}
+		for !clone.IsEmpty() {
+			value, _ := clone.PollMin()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields the values from largest to smallest without modifying the heap.
+func (q *MinMaxHeap[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		clone := &MinMaxHeap[T]{items: slices.Clone(q.items), comparator: q.comparator}
+		for !clone.IsEmpty() {
+			value, _ := clone.PollMax()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (q *MinMaxHeap[T]) maxIndex() int {
+	switch {
+	case len(q.items) < 3:
+		return len(q.items) - 1
+	case q.comparator(q.items[1], q.items[2]) >= 0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func (q *MinMaxHeap[T]) push(value T) {
+	q.items = append(q.items, value)
+	index := len(q.items) - 1
+	if index == 0 {
+		return
+	}
+
+	// a value that belongs on the other kind of level first swaps with its
+	// parent, then climbs the levels of its kind
+	parent := (index - 1) / 2
+	isMin := isMinLevel(index)
+	if q.before(parent, index, isMin) {
+		q.items[parent], q.items[index] = q.items[index], q.items[parent]
+		q.bubbleUp(parent, !isMin)
+	} else {
+		q.bubbleUp(index, isMin)
+	}
+}
+
+// removeAt replaces the item at index with the last one and trickles it down.
+func (q *MinMaxHeap[T]) removeAt(index int) {
+	last := len(q.items) - 1
+	q.items[index] = q.items[last]
+	var zero T
+	q.items[last] = zero
+	q.items = q.items[:last]
+
+	if index < last {
+		q.trickleDown(index, isMinLevel(index))
+	}
+}
+
+// bubbleUp moves the item at index up its grandparents, which are on levels
+// of the same kind.
+func (q *MinMaxHeap[T]) bubbleUp(index int, isMin bool) {
+	for index > 2 {
+		grandparent := ((index-1)/2 - 1) / 2
+		if !q.before(index, grandparent, isMin) {
+			return
+		}
+
+		q.items[grandparent], q.items[index] = q.items[index], q.items[grandparent]
+		index = grandparent
+	}
+}
+
+// trickleDown moves the item at index down towards its smallest (or largest)
+// descendant among its children and grandchildren.
+func (q *MinMaxHeap[T]) trickleDown(index int, isMin bool) {
+	for {
+		first := index*2 + 1
+		if first >= len(q.items) {
+			return
+		}
+
+		best := first
+		candidates := []int{first + 1, first*2 + 1, first*2 + 2, first*2 + 3, first*2 + 4}
+		for _, candidate := range candidates {
+			if candidate < len(q.items) && q.before(candidate, best, isMin) {
+				best = candidate
+			}
+		}
+
+		if !q.before(best, index, isMin) {
+			return
+		}
+		q.items[best], q.items[index] = q.items[index], q.items[best]
+
+		if best <= first+1 {
+			// a child is on the other kind of level and has no grandchildren
+			// to compare against
+			return
+		}
+
+		parent := (best - 1) / 2
+		if q.before(parent, best, isMin) {
+			q.items[parent], q.items[best] = q.items[best], q.items[parent]
+		}
+		index = best
+	}
+}
+
+// before reports whether the item at i belongs above the item at j on a min
+// level, or on a max level if isMin is false.
+func (q *MinMaxHeap[T]) before(i, j int, isMin bool) bool {
+	result := q.comparator(q.items[i], q.items[j])
+	if isMin {
+		return result < 0
+	}
+	return result > 0
+}
+
+func isMinLevel(index int) bool {
+	return bits.Len(uint(index+1))%2 == 1
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMinMaxHeap(t *testing.T) {
+	heap := NewMinMaxHeap[int](func(a, b int) int { return a - b })
+	_, err := heap.PeekMin()
+	require.NotNil(t, err, "MinMaxHeap peek min is not failed")
+	_, err = heap.PollMax()
+	require.NotNil(t, err, "MinMaxHeap poll max is not failed")
+
+	heap.OfferValues([]int{5, 3, 9, 1, 7})
+	require.Equal(t, 5, heap.Size(), "MinMaxHeap size is not equal")
+	require.Equal(t, 0, heap.Capacity(), "MinMaxHeap capacity is not equal")
+	require.True(t, heap.Contains(9), "MinMaxHeap contains(9) is not matched")
+	require.False(t, heap.Contains(2), "MinMaxHeap contains(2) is not matched")
+
+	minimum, _ := heap.PeekMin()
+	maximum, _ := heap.PeekMax()
+	require.Equal(t, 1, minimum, "MinMaxHeap peek min is not matched")
+	require.Equal(t, 9, maximum, "MinMaxHeap peek max is not matched")
+
+	require.Equal(t, []int{1, 3, 5, 7, 9}, slices.Collect(heap.All()), "MinMaxHeap all is not matched")
+	require.Equal(t, []int{9, 7, 5, 3, 1}, slices.Collect(heap.Backward()), "MinMaxHeap backward is not matched")
+	require.Equal(t, 5, heap.Size(), "MinMaxHeap iteration modified the heap")
+
+	maximum, _ = heap.PollMax()
+	require.Equal(t, 9, maximum, "MinMaxHeap poll max is not matched")
+	minimum, _ = heap.Poll()
+	require.Equal(t, 1, minimum, "MinMaxHeap poll is not matched")
+	require.ElementsMatch(t, []int{3, 5, 7}, heap.Values(), "MinMaxHeap values are not equal")
+
+	heap.Clear()
+	require.True(t, heap.IsEmpty(), "MinMaxHeap clear is not matched")
+}
+
+func TestMinMaxHeap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	heap := NewMinMaxHeap[int](func(a, b int) int { return a - b })
+	expected := []int{}
+
+	for i := 0; i < 5000; i++ {
+		switch random.Intn(4) {
+		case 0:
+			if value, err := heap.PollMin(); err == nil {
+				require.Equal(t, expected[0], value, "MinMaxHeap poll min is not matched")
+				expected = expected[1:]
+			}
+		case 1:
+			if value, err := heap.PollMax(); err == nil {
+				require.Equal(t, expected[len(expected)-1], value, "MinMaxHeap poll max is not matched")
+				expected = expected[:len(expected)-1]
+			}
+		default:
+			value := random.Intn(1000)
+			heap.Offer(value)
+			expected = append(expected, value)
+			slices.Sort(expected)
+		}
+
+		require.Equal(t, len(expected), heap.Size(), "MinMaxHeap size is not equal")
+		if len(expected) > 0 {
+			minimum, _ := heap.PeekMin()
+			maximum, _ := heap.PeekMax()
+			require.Equal(t, expected[0], minimum, "MinMaxHeap peek min is not matched")
+			require.Equal(t, expected[len(expected)-1], maximum, "MinMaxHeap peek max is not matched")
+		}
+	}
+}
+
+func TestMinMaxHeap_Bounded(t *testing.T) {
+	// a reversed comparator keeps the largest values and evicts the smallest
+	heap := NewBoundedMinMaxHeap[int](3, func(a, b int) int { return b - a })
+	for _, value := range []int{4, 8, 1} {
+		_, evicted := heap.OfferEvict(value)
+		require.False(t, evicted, "MinMaxHeap offer under capacity is not matched")
+	}
+	require.True(t, heap.IsFull(), "MinMaxHeap is full is not matched")
+
+	evicted, ok := heap.OfferEvict(6)
+	require.True(t, ok, "MinMaxHeap offer over capacity is not matched")
+	require.Equal(t, 1, evicted, "MinMaxHeap evicted value is not matched")
+
+	evicted, ok = heap.OfferEvict(2)
+	require.True(t, ok, "MinMaxHeap offer over capacity is not matched")
+	require.Equal(t, 2, evicted, "MinMaxHeap evicted value is not matched")
+	require.Equal(t, []int{8, 6, 4}, slices.Collect(heap.All()), "MinMaxHeap top k is not matched")
+
+	random := rand.New(rand.NewSource(1))
+	values := random.Perm(1000)
+	topK := NewBoundedMinMaxHeap[int](10, func(a, b int) int { return b - a })
+	topK.OfferValues(values)
+	require.Equal(t, 10, topK.Size(), "MinMaxHeap size is not equal")
+	require.Equal(t, []int{999, 998, 997, 996, 995, 994, 993, 992, 991, 990}, slices.Collect(topK.All()), "MinMaxHeap top k is not matched")
+}
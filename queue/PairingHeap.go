@@ -0,0 +1,159 @@
+package queue
+
+import (
+	"go-utils/collection"
+)
+
+var _ collection.Queue[int] = (*PairingHeap[int])(nil)
+
+type pairingNode[T comparable] struct {
+	value   T
+	child   *pairingNode[T]
+	sibling *pairingNode[T]
+}
+
+// PairingHeap is a priority queue with O(1) Offer, Peek and Meld, and
+// O(log n) amortized Poll. Prefer it over PriorityQueue when heaps are
+// combined often.
+type PairingHeap[T comparable] struct {
+	root       *pairingNode[T]
+	size       int
+	comparator func(a, b T) int
+}
+
+func NewPairingHeap[T comparable](comparator func(a, b T) int) *PairingHeap[T] {
+	return &PairingHeap[T]{comparator: comparator}
+}
+
+func (q *PairingHeap[T]) Size() int {
+	return q.size
+}
+
+func (q *PairingHeap[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// Values returns the values in heap order, not priority order.
+func (q *PairingHeap[T]) Values() []T {
+	values := make([]T, 0, q.size)
+	q.each(func(value T) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+func (q *PairingHeap[T]) Clear() {
+	q.root = nil
+	q.size = 0
+}
+
+func (q *PairingHeap[T]) Contains(value T) bool {
+	found := false
+	q.each(func(item T) bool {
+		found = item == value
+		return !found
+	})
+	return found
+}
+
+func (q *PairingHeap[T]) Offer(value T) {
+	q.root = q.meld(q.root, &pairingNode[T]{value: value})
+	q.size++
+}
+
+func (q *PairingHeap[T]) OfferValues(values []T) {
+	for _, value := range values {
+		q.Offer(value)
+	}
+}
+
+func (q *PairingHeap[T]) Peek() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.root.value, nil
+}
+
+func (q *PairingHeap[T]) Poll() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	value := q.root.value
+	q.root = q.mergePairs(q.root.child)
+	q.size--
+	return value, nil
+}
+
+// Meld moves every value of other into q in O(1), leaving other empty. Both
+// heaps must use the same ordering.
+func (q *PairingHeap[T]) Meld(other *PairingHeap[T]) {
+	if other == q {
+		return
+	}
+
+	q.root = q.meld(q.root, other.root)
+	q.size += other.size
+	other.Clear()
+}
+
+func (q *PairingHeap[T]) meld(a, b *pairingNode[T]) *pairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+
+	if q.comparator(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.sibling = a.child
+	a.child = b
+	return a
+}
+
+// mergePairs melds the children of a polled root in two passes: adjacent
+// pairs from left to right, then the results from right to left.
+func (q *PairingHeap[T]) mergePairs(first *pairingNode[T]) *pairingNode[T] {
+	pairs := []*pairingNode[T]{}
+	for node := first; node != nil; {
+		a, b := node, node.sibling
+		if b == nil {
+			pairs = append(pairs, a)
+			break
+		}
+
+		node = b.sibling
+		a.sibling, b.sibling = nil, nil
+		pairs = append(pairs, q.meld(a, b))
+	}
+
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = q.meld(pairs[i], root)
+	}
+	return root
+}
+
+func (q *PairingHeap[T]) each(action func(T) bool) {
+	if q.root == nil {
+		return
+	}
+
+	nodes := []*pairingNode[T]{q.root}
+	for len(nodes) > 0 {
+		node := nodes[len(nodes)-1]
+		nodes = nodes[:len(nodes)-1]
+		if !action(node.value) {
+			return
+		}
+
+		for child := node.child; child != nil; child = child.sibling {
+			nodes = append(nodes, child)
+		}
+	}
+}
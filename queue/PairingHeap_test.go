@@ -0,0 +1,72 @@
+package queue
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPairingHeap(t *testing.T) {
+	heap := NewPairingHeap[int](func(a, b int) int { return a - b })
+	_, err := heap.Poll()
+	require.NotNil(t, err, "PairingHeap poll is not failed")
+	_, err = heap.Peek()
+	require.NotNil(t, err, "PairingHeap peek is not failed")
+
+	heap.OfferValues([]int{5, 3, 8, 1})
+	require.Equal(t, 4, heap.Size(), "PairingHeap size is not equal")
+	require.ElementsMatch(t, []int{1, 3, 5, 8}, heap.Values(), "PairingHeap values are not equal")
+	require.True(t, heap.Contains(8), "PairingHeap contains(8) is not matched")
+	require.False(t, heap.Contains(2), "PairingHeap contains(2) is not matched")
+
+	other := NewPairingHeap[int](func(a, b int) int { return a - b })
+	other.OfferValues([]int{2, 0, 9})
+	heap.Meld(other)
+	require.True(t, other.IsEmpty(), "PairingHeap meld did not empty the other heap")
+	require.Equal(t, 7, heap.Size(), "PairingHeap size is not equal")
+	heap.Meld(heap)
+	require.Equal(t, 7, heap.Size(), "PairingHeap meld with itself is not ignored")
+
+	polled := []int{}
+	for !heap.IsEmpty() {
+		peeked, _ := heap.Peek()
+		value, _ := heap.Poll()
+		require.Equal(t, peeked, value, "PairingHeap peek and poll are not matched")
+		polled = append(polled, value)
+	}
+	require.Equal(t, []int{0, 1, 2, 3, 5, 8, 9}, polled, "PairingHeap poll order is not matched")
+}
+
+func TestPairingHeap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	comparator := func(a, b int) int { return a - b }
+	heap := NewPairingHeap[int](comparator)
+	expected := []int{}
+
+	for i := 0; i < 5000; i++ {
+		switch random.Intn(5) {
+		case 0, 1:
+			if value, err := heap.Poll(); err == nil {
+				require.Equal(t, expected[0], value, "PairingHeap poll is not matched")
+				expected = expected[1:]
+			}
+		case 2:
+			other := NewPairingHeap[int](comparator)
+			for j := random.Intn(5); j > 0; j-- {
+				value := random.Intn(1000)
+				other.Offer(value)
+				expected = append(expected, value)
+			}
+			heap.Meld(other)
+			slices.Sort(expected)
+		default:
+			value := random.Intn(1000)
+			heap.Offer(value)
+			expected = append(expected, value)
+			slices.Sort(expected)
+		}
+		require.Equal(t, len(expected), heap.Size(), "PairingHeap size is not equal")
+	}
+}
@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"context"
+	"go-utils/collection"
+	"sync"
+	"time"
+)
+
+var _ collection.Collection[int] = (*PriorityBlockingQueue[int])(nil)
+
+// PriorityBlockingQueue is an unbounded priority queue whose Take waits until
+// a value is available.
+type PriorityBlockingQueue[T comparable] struct {
+	mu      sync.Mutex
+	queue   *PriorityQueue[T]
+	closed  bool
+	changed signal
+}
+
+func NewPriorityBlockingQueue[T comparable](comparator func(a, b T) int) *PriorityBlockingQueue[T] {
+	return &PriorityBlockingQueue[T]{
+		queue:   NewPriorityQueue[T](comparator),
+		changed: newSignal(),
+	}
+}
+
+func (q *PriorityBlockingQueue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Size()
+}
+
+func (q *PriorityBlockingQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Values returns every value in priority order.
+func (q *PriorityBlockingQueue[T]) Values() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.SortedValues()
+}
+
+func (q *PriorityBlockingQueue[T]) Clear() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.queue.Clear()
+	q.changed.broadcast()
+}
+
+func (q *PriorityBlockingQueue[T]) Contains(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Contains(value)
+}
+
+// Offer adds the value and reports whether it was added; it only fails once
+// the queue is closed.
+func (q *PriorityBlockingQueue[T]) Offer(value T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	q.queue.Offer(value)
+	q.changed.broadcast()
+	return true
+}
+
+func (q *PriorityBlockingQueue[T]) Poll() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Poll()
+}
+
+func (q *PriorityBlockingQueue[T]) Peek() (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.queue.Peek()
+}
+
+func (q *PriorityBlockingQueue[T]) Take() (T, error) {
+	return q.TakeContext(context.Background())
+}
+
+func (q *PriorityBlockingQueue[T]) PollTimeout(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return q.TakeContext(ctx)
+}
+
+// TakeContext waits until a value is available. Values offered before Close
+// can still be taken; after that it fails with ErrQueueClosed.
+func (q *PriorityBlockingQueue[T]) TakeContext(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	for q.queue.IsEmpty() {
+		if q.closed {
+			q.mu.Unlock()
+			var zero T
+			return zero, ErrQueueClosed
+		}
+
+		if err := q.changed.wait(ctx, &q.mu, nil); err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+	defer q.mu.Unlock()
+
+	return q.queue.Poll()
+}
+
+// DrainTo moves every value into target in priority order and returns how many
+// were moved.
+func (q *PriorityBlockingQueue[T]) DrainTo(target collection.Queue[T]) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	count := 0
+	for !q.queue.IsEmpty() {
+		value, _ := q.queue.Poll()
+		target.Offer(value)
+		count++
+	}
+	return count
+}
+
+// Close rejects further offers and wakes up every waiting goroutine.
+func (q *PriorityBlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.changed.broadcast()
+}
+
+func (q *PriorityBlockingQueue[T]) IsClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.closed
+}
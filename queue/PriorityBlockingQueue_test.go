@@ -0,0 +1,84 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityBlockingQueue(t *testing.T) {
+	queue := NewPriorityBlockingQueue[int](func(a, b int) int { return a - b })
+	require.True(t, queue.IsEmpty(), "PriorityBlockingQueue is not empty")
+
+	require.True(t, queue.Offer(5), "PriorityBlockingQueue offer is failed")
+	require.True(t, queue.Offer(1), "PriorityBlockingQueue offer is failed")
+	require.True(t, queue.Offer(3), "PriorityBlockingQueue offer is failed")
+	require.Equal(t, 3, queue.Size(), "PriorityBlockingQueue size is not equal")
+	require.True(t, queue.Contains(3), "PriorityBlockingQueue contains(3) is not matched")
+	require.Equal(t, []int{1, 3, 5}, queue.Values(), "PriorityBlockingQueue values are not equal")
+
+	value, err := queue.Peek()
+	require.Nil(t, err, "PriorityBlockingQueue peek is failed")
+	require.Equal(t, 1, value, "PriorityBlockingQueue peek item is not matched")
+
+	value, err = queue.Take()
+	require.Nil(t, err, "PriorityBlockingQueue take is failed")
+	require.Equal(t, 1, value, "PriorityBlockingQueue take item is not matched")
+
+	target := NewQueue[int]()
+	require.Equal(t, 2, queue.DrainTo(target), "PriorityBlockingQueue drain count is not equal")
+	require.Equal(t, []int{3, 5}, target.Values(), "PriorityBlockingQueue drained values are not equal")
+
+	_, err = queue.PollTimeout(20 * time.Millisecond)
+	require.ErrorIs(t, err, context.DeadlineExceeded, "PriorityBlockingQueue poll timeout is not expired")
+}
+
+func TestPriorityBlockingQueue_TakeWaitsForOffer(t *testing.T) {
+	queue := NewPriorityBlockingQueue[int](func(a, b int) int { return a - b })
+
+	var wg sync.WaitGroup
+	results := make(chan int, 3)
+	for c := 0; c < 3; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := queue.Take()
+			if err == nil {
+				results <- value
+			}
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	queue.Offer(2)
+	queue.Offer(1)
+	queue.Offer(3)
+	wg.Wait()
+	close(results)
+
+	consumed := []int{}
+	for value := range results {
+		consumed = append(consumed, value)
+	}
+	require.ElementsMatch(t, []int{1, 2, 3}, consumed, "PriorityBlockingQueue consumed values are not matched")
+}
+
+func TestPriorityBlockingQueue_Close(t *testing.T) {
+	queue := NewPriorityBlockingQueue[int](func(a, b int) int { return a - b })
+	queue.Offer(1)
+
+	done := make(chan error)
+	go func() {
+		_, _ = queue.Take()
+		_, err := queue.Take()
+		done <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	queue.Close()
+	require.ErrorIs(t, <-done, ErrQueueClosed, "PriorityBlockingQueue take is not woken up by close")
+	require.False(t, queue.Offer(2), "PriorityBlockingQueue offer after close is not rejected")
+}
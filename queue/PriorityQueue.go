@@ -1,10 +1,14 @@
 package queue
 
 import (
-	"errors"
 	"go-utils/array"
+	"go-utils/collection"
+	"iter"
+	"slices"
 )
 
+var _ collection.Queue[int] = (*PriorityQueue[int])(nil)
+
 type PriorityQueue[T comparable] struct {
 	*array.Array[T]
 	comparator func(a, b T) int
@@ -17,34 +21,44 @@ func NewPriorityQueue[T comparable](comparator func(a, b T) int) *PriorityQueue[
 	}
 }
 
+// NewPriorityQueueFrom creates a priority queue holding a copy of values,
+// built bottom-up in O(n) instead of offering the values one by one.
+func NewPriorityQueueFrom[T comparable](values []T, comparator func(a, b T) int) *PriorityQueue[T] {
+	s := NewPriorityQueue[T](comparator)
+	s.AddAll(values)
+	s.heapify()
+	return s
+}
+
 func (s *PriorityQueue[T]) Offer(value T) {
-	// add to the tail
+	// add to the tail and blow up
 	s.Add(value)
+	siftUp(s, s.Size()-1)
+}
 
-	// blow up
-	pos := s.Size() - 1
-	for pos > 0 {
-		top := (pos - 1) / 2
-		if s.Compare(top, pos, s.comparator) <= 0 {
-			break
+// OfferValues rebuilds the heap in O(n) when adding at least as many values as
+// the queue holds, and offers them one by one otherwise.
+func (s *PriorityQueue[T]) OfferValues(values []T) {
+	if len(values) < s.Size() {
+		for _, value := range values {
+			s.Offer(value)
 		}
-
-		// swap
-		s.Swap(top, pos)
-		pos = top
+		return
 	}
+
+	s.AddAll(values)
+	s.heapify()
 }
 
-func (s *PriorityQueue[T]) OfferValues(values []T) {
-	for _, value := range values {
-		s.Offer(value)
-	}
+// Merge adds the values of other, which is left unchanged, in O(n + m).
+func (s *PriorityQueue[T]) Merge(other *PriorityQueue[T]) {
+	s.OfferValues(other.Values())
 }
 
 func (s *PriorityQueue[T]) Peek() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("queue is empty")
+		return zero, collection.ErrEmpty
 	}
 
 	return s.Get(0)
@@ -53,36 +67,145 @@ func (s *PriorityQueue[T]) Peek() (T, error) {
 func (s *PriorityQueue[T]) Poll() (T, error) {
 	if s.IsEmpty() {
 		var zero T
-		return zero, errors.New("queue is empty")
+		return zero, collection.ErrEmpty
 	}
 
 	value, _ := s.Get(0)
 	last, _ := s.RemoveAt(s.Size() - 1)
 	if !s.IsEmpty() {
 		s.SetAt(0, last)
+		siftDown(s, 0, s.Size())
+	}
 
-		length := s.Size()
-		index := 0
-		for {
-			left := index*2 + 1
-			right := index*2 + 2
-			smallest := index
+	return value, nil
+}
 
-			if left < length && s.Compare(left, smallest, s.comparator) <= 0 {
-				smallest = left
-			}
-			if right < length && s.Compare(right, smallest, s.comparator) <= 0 {
-				smallest = right
+// MustPeek is like Peek but panics on error.
+func (s *PriorityQueue[T]) MustPeek() T {
+	return collection.Must(s.Peek())
+}
+
+// MustPoll is like Poll but panics on error.
+func (s *PriorityQueue[T]) MustPoll() T {
+	return collection.Must(s.Poll())
+}
+
+// PollN removes and returns up to n values in priority order.
+func (s *PriorityQueue[T]) PollN(n int) []T {
+	values := make([]T, 0, min(max(n, 0), s.Size()))
+	for len(values) < n && !s.IsEmpty() {
+		value, _ := s.Poll()
+		values = append(values, value)
+	}
+	return values
+}
+
+// PushPop offers value and then polls, in a single sift. If value would come
+// first it is returned right away.
+func (s *PriorityQueue[T]) PushPop(value T) T {
+	top, err := s.Get(0)
+	if err != nil || s.comparator(value, top) <= 0 {
+		return value
+	}
+
+	s.SetAt(0, value)
+	siftDown(s, 0, s.Size())
+	return top
+}
+
+// Replace polls and then offers value, in a single sift. Unlike PushPop it
+// fails on an empty queue, and the returned value may rank after value.
+func (s *PriorityQueue[T]) Replace(value T) (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	top, _ := s.Get(0)
+	s.SetAt(0, value)
+	siftDown(s, 0, s.Size())
+	return top, nil
+}
+
+// SortedValues returns the values in priority order without modifying the queue.
+func (s *PriorityQueue[T]) SortedValues() []T {
+	values := slices.Clone(s.Values())
+	slices.SortStableFunc(values, s.comparator)
+	return values
+}
+
+// All yields the values in priority order without modifying the queue.
+func (s *PriorityQueue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		clone := &PriorityQueue[T]{s.Clone(), s.comparator}
+		for !clone.IsEmpty() {
+			value, _ := clone.Poll()
+			if !yield(value) {
+				return
 			}
+		}
+	}
+}
 
-			if smallest == index {
-				break
+// Backward yields the values in reverse priority order without modifying the queue.
+func (s *PriorityQueue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		values := array.Collect(s.All())
+		for value := range values.Backward() {
+			if !yield(value) {
+				return
 			}
+		}
+	}
+}
 
-			s.Swap(smallest, index)
-			index = smallest
+func (s *PriorityQueue[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for value := range s.All() {
+			if !yield(index, value) {
+				return
+			}
+			index++
 		}
 	}
+}
+
+// removeAt removes the value at index by moving the last value of the heap
+// into its place. It returns that value if it ended up before index.
+func (s *PriorityQueue[T]) removeAt(index int) (T, bool) {
+	var zero T
+	last := s.Size() - 1
+	moved, _ := s.RemoveAt(last)
+	if index == last {
+		return zero, false
+	}
 
-	return value, nil
+	s.SetAt(index, moved)
+	if siftDown(s, index, s.Size()) == index && siftUp(s, index) != index {
+		return moved, true
+	}
+	return zero, false
+}
+
+func (s *PriorityQueue[T]) heapify() {
+	for i := s.Size()/2 - 1; i >= 0; i-- {
+		siftDown(s, i, s.Size())
+	}
+}
+
+func (s *PriorityQueue[T]) compare(i, j int) int {
+	return s.Compare(i, j, s.comparator)
+}
+
+func (s *PriorityQueue[T]) swap(i, j int) {
+	s.Swap(i, j)
+}
+
+func CollectPriorityQueue[T comparable](seq iter.Seq[T], comparator func(a, b T) int) *PriorityQueue[T] {
+	result := NewPriorityQueue[T](comparator)
+	for value := range seq {
+		result.Offer(value)
+	}
+	return result
 }
@@ -0,0 +1,96 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"slices"
+)
+
+// PriorityQueueIterator walks a PriorityQueue in heap order, not priority
+// order. It fails fast: once the queue changes size other than through
+// Remove, HasNext returns false and Err reports
+// collection.ErrConcurrentModification.
+type PriorityQueueIterator[T comparable] struct {
+	queue *PriorityQueue[T]
+	index int
+	last  int
+	// forgotten holds the values that Remove moved before the cursor; they
+	// are returned after the rest of the heap.
+	forgotten     []T
+	lastForgotten bool
+	lastValue     T
+	modCount      int
+	err           error
+}
+
+// HeapIterator returns an iterator over the heap whose Remove keeps the heap
+// ordered. The Iterator of the embedded array also fails fast, but its Remove
+// only deletes the value from the slice and breaks the heap.
+func (s *PriorityQueue[T]) HeapIterator() *PriorityQueueIterator[T] {
+	return &PriorityQueueIterator[T]{queue: s, last: -1, modCount: s.ModCount()}
+}
+
+func (it *PriorityQueueIterator[T]) HasNext() bool {
+	return it.check() == nil && (it.index < it.queue.Size() || len(it.forgotten) > 0)
+}
+
+// Next returns the next value, or the zero value if there is none or the
+// queue was modified.
+func (it *PriorityQueueIterator[T]) Next() T {
+	if !it.HasNext() {
+		var zero T
+		return zero
+	}
+
+	if it.index < it.queue.Size() {
+		value, _ := it.queue.Get(it.index)
+		it.last = it.index
+		it.index++
+		return value
+	}
+
+	it.last = -1
+	it.lastForgotten = true
+	it.lastValue = it.forgotten[0]
+	it.forgotten = it.forgotten[1:]
+	return it.lastValue
+}
+
+// Remove removes the value last returned by Next. It is the only way to
+// remove values while iterating without failing the iterator.
+func (it *PriorityQueueIterator[T]) Remove() error {
+	if err := it.check(); err != nil {
+		return err
+	}
+
+	switch {
+	case it.last >= 0:
+		if moved, ok := it.queue.removeAt(it.last); ok {
+			it.forgotten = append(it.forgotten, moved)
+		} else {
+			// the value now at last has not been returned yet
+			it.index = it.last
+		}
+		it.last = -1
+	case it.lastForgotten:
+		it.queue.removeAt(slices.Index(it.queue.Values(), it.lastValue))
+		it.lastForgotten = false
+	default:
+		return collection.ErrIllegalState
+	}
+
+	it.modCount = it.queue.ModCount()
+	return nil
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the queue.
+func (it *PriorityQueueIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *PriorityQueueIterator[T]) check() error {
+	if it.err == nil && it.modCount != it.queue.ModCount() {
+		it.err = collection.ErrConcurrentModification
+	}
+	return it.err
+}
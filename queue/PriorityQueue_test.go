@@ -1,6 +1,10 @@
 package queue
 
 import (
+    "go-utils/array"
+    "go-utils/collection"
+    "math/rand"
+    "slices"
     "testing"
 
     "github.com/stretchr/testify/assert"
@@ -78,3 +82,142 @@ func validatePriorityQueuePeek(t *testing.T, queue *PriorityQueue[int], expected
     require.Nil(t, err, "PriorityQueue peek is failed")
     require.Equal(t, expectedValue, value, "PriorityQueue peek item is not matched")
 }
+
+func TestPriorityQueue_All(t *testing.T) {
+    queue := CollectPriorityQueue(slices.Values([]int{5, 3, 8, 1, 9, 2}), func(a, b int) int { return a - b })
+
+    assert.Equal(t, []int{1, 2, 3, 5, 8, 9}, slices.Collect(queue.All()), "PriorityQueue all is failed")
+    assert.Equal(t, []int{9, 8, 5, 3, 2, 1}, slices.Collect(queue.Backward()), "PriorityQueue backward is failed")
+    assert.Equal(t, 6, queue.Size(), "PriorityQueue size is not equal")
+
+    indexes := []int{}
+    for i, value := range queue.Enumerate() {
+        if i == 2 {
+            assert.Equal(t, 3, value, "PriorityQueue enumerate item is not matched")
+            break
+        }
+        indexes = append(indexes, i)
+    }
+    assert.Equal(t, []int{0, 1}, indexes, "PriorityQueue enumerate is failed")
+
+    validatePriorityQueuePoll(t, queue, 1)
+    validatePriorityQueuePoll(t, queue, 2)
+}
+
+func TestPriorityQueue_From(t *testing.T) {
+    values := rand.New(rand.NewSource(1)).Perm(1000)
+    queue := NewPriorityQueueFrom(values, func(a, b int) int { return a - b })
+    require.Equal(t, 1000, queue.Size(), "PriorityQueue size is not equal")
+    require.Equal(t, 1000, len(values), "PriorityQueue from modified the values")
+
+    sorted := queue.SortedValues()
+    require.True(t, slices.IsSorted(sorted), "PriorityQueue sorted values are not sorted")
+    require.Equal(t, 1000, queue.Size(), "PriorityQueue sorted values modified the queue")
+
+    require.Equal(t, []int{0, 1, 2}, queue.PollN(3), "PriorityQueue poll n is not matched")
+    require.Equal(t, 997, len(queue.PollN(2000)), "PriorityQueue poll n over size is not matched")
+    require.Empty(t, queue.PollN(1), "PriorityQueue poll n on empty is not empty")
+}
+
+func TestPriorityQueue_OfferValuesAndMerge(t *testing.T) {
+    comparator := func(a, b int) int { return a - b }
+    queue := NewPriorityQueue[int](comparator)
+    queue.OfferValues([]int{9, 4, 7})
+    queue.OfferValues([]int{1})
+    queue.OfferValues([]int{8, 2, 6, 3, 5})
+
+    other := NewPriorityQueueFrom([]int{0, 10}, comparator)
+    queue.Merge(other)
+    require.Equal(t, 2, other.Size(), "PriorityQueue merge modified the other queue")
+    require.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, queue.PollN(20), "PriorityQueue merge is not matched")
+}
+
+func TestPriorityQueue_PushPopAndReplace(t *testing.T) {
+    queue := NewPriorityQueue[int](func(a, b int) int { return a - b })
+    require.Equal(t, 5, queue.PushPop(5), "PriorityQueue push pop on empty is not matched")
+    _, err := queue.Replace(5)
+    require.NotNil(t, err, "PriorityQueue replace on empty is not failed")
+
+    queue.OfferValues([]int{3, 6, 9})
+    require.Equal(t, 1, queue.PushPop(1), "PriorityQueue push pop of a smaller value is not matched")
+    require.Equal(t, 3, queue.PushPop(7), "PriorityQueue push pop is not matched")
+    require.Equal(t, []int{6, 7, 9}, queue.SortedValues(), "PriorityQueue values are not equal")
+
+    top, err := queue.Replace(10)
+    require.Nil(t, err, "PriorityQueue replace is failed")
+    require.Equal(t, 6, top, "PriorityQueue replace is not matched")
+    require.Equal(t, []int{7, 9, 10}, queue.SortedValues(), "PriorityQueue values are not equal")
+}
+
+func BenchmarkPriorityQueue_Build(b *testing.B) {
+    values := rand.New(rand.NewSource(1)).Perm(100_000)
+    comparator := func(a, b int) int { return a - b }
+
+    b.Run("Offer", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            queue := NewPriorityQueue[int](comparator)
+            for _, value := range values {
+                queue.Offer(value)
+            }
+        }
+    })
+    b.Run("Heapify", func(b *testing.B) {
+        for i := 0; i < b.N; i++ {
+            NewPriorityQueueFrom(values, comparator)
+        }
+    })
+}
+
+func TestPriorityQueue_HeapIteratorRemove(t *testing.T) {
+    random := rand.New(rand.NewSource(1))
+    for round := 0; round < 50; round++ {
+        values := random.Perm(200)
+        queue := NewPriorityQueueFrom(values, func(a, b int) int { return a - b })
+
+        it := queue.HeapIterator()
+        require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, "PriorityQueue heap iterator remove before next is not failed")
+        visited := []int{}
+        kept := []int{}
+        for it.HasNext() {
+            value := it.Next()
+            visited = append(visited, value)
+            if random.Intn(2) == 0 {
+                require.Nil(t, it.Remove(), "PriorityQueue heap iterator remove is failed")
+            } else {
+                kept = append(kept, value)
+            }
+        }
+        require.Nil(t, it.Err(), "PriorityQueue heap iterator error is not nil")
+        require.ElementsMatch(t, values, visited, "PriorityQueue heap iterator values are not matched")
+
+        slices.Sort(kept)
+        require.Equal(t, kept, queue.PollN(len(values)), "PriorityQueue order after iterator remove is not matched")
+    }
+}
+
+func TestPriorityQueue_HeapIteratorFailFast(t *testing.T) {
+    queue := NewPriorityQueueFrom([]int{3, 1, 2}, func(a, b int) int { return a - b })
+    it := queue.HeapIterator()
+    it.Next()
+    queue.Offer(0)
+    require.False(t, it.HasNext(), "PriorityQueue heap iterator has next after offer is not matched")
+    require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "PriorityQueue heap iterator error is not matched")
+}
+
+func TestPriorityQueue_Iterator(t *testing.T) {
+    queue := NewPriorityQueueFrom([]int{3, 1, 2}, func(a, b int) int { return a - b })
+    visited := []int{}
+    queue.Iterator().Each(func(value int) {
+        visited = append(visited, value)
+    })
+    require.ElementsMatch(t, []int{1, 2, 3}, visited, "PriorityQueue iterator values are not matched")
+
+    doubled := array.Map(queue.Iterator(), func(value int) int { return value * 2 })
+    require.ElementsMatch(t, []int{2, 4, 6}, doubled.Values(), "PriorityQueue mapped values are not matched")
+
+    it := queue.Iterator()
+    it.Next()
+    queue.Offer(0)
+    require.False(t, it.HasNext(), "PriorityQueue iterator has next after offer is not matched")
+    require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, "PriorityQueue iterator error is not matched")
+}
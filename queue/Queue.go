@@ -1,6 +1,14 @@
 package queue
 
-import "go-utils/list"
+import (
+    "go-utils/collection"
+    "go-utils/list"
+)
+
+var (
+    _ collection.Queue[int] = (*Queue[int])(nil)
+    _ collection.Deque[int] = (*Queue[int])(nil)
+)
 
 type Queue[T comparable] struct {
     *list.LinkedList[T]
@@ -25,3 +33,13 @@ func (s *Queue[T]) Poll() (T, error) {
 func (s *Queue[T]) Peek() (T, error) {
     return s.GetHead()
 }
+
+// MustPoll is like Poll but panics on error.
+func (s *Queue[T]) MustPoll() T {
+    return collection.Must(s.Poll())
+}
+
+// MustPeek is like Peek but panics on error.
+func (s *Queue[T]) MustPeek() T {
+    return collection.Must(s.Peek())
+}
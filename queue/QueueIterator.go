@@ -1,22 +1,50 @@
 package queue
 
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+// Iterator walks a Queue or an ArrayQueue from head to tail in O(1) per step.
+// It fails fast: once the queue is modified other than through Remove,
+// HasNext returns false and Err reports collection.ErrConcurrentModification.
 type Iterator[T comparable] struct {
-	queue *Queue[T]
-	index int
+	// it is the Iterator of the embedded list.LinkedList or deque.ArrayDeque.
+	it collection.Iterator[T]
 }
 
 func (s *Queue[T]) Iterator() *Iterator[T] {
-	return &Iterator[T]{queue: s, index: 0}
+	return &Iterator[T]{s.LinkedList.Iterator()}
+}
+
+func Collect[T comparable](seq iter.Seq[T]) *Queue[T] {
+	result := NewQueue[T]()
+	for value := range seq {
+		result.Offer(value)
+	}
+	return result
 }
 
 func (it *Iterator[T]) HasNext() bool {
-	return it.index < it.queue.Size()
+	return it.it.HasNext()
 }
 
+// Next returns the next value, or the zero value if there is none or the
+// queue was modified.
 func (it *Iterator[T]) Next() T {
-	value, _ := it.queue.GetAt(it.index)
-	it.index++
-	return value
+	return it.it.Next()
+}
+
+// Remove removes the value last returned by Next. It is the only way to
+// remove values while iterating without failing the iterator.
+func (it *Iterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the queue.
+func (it *Iterator[T]) Err() error {
+	return it.it.Err()
 }
 
 func (it *Iterator[T]) Each(action func(T)) {
@@ -25,6 +53,10 @@ func (it *Iterator[T]) Each(action func(T)) {
 	}
 }
 
+// Map builds a Queue of the mapped values.
+//
+// Deprecated: use stream.Map, which maps lazily instead of building a Queue
+// of every result first.
 func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *Queue[V] {
 	result := NewQueue[V]()
 	for it.HasNext() {
@@ -33,6 +65,9 @@ func Map[T comparable, V comparable](it *Iterator[T], mapper func(T) V) *Queue[V
 	return result
 }
 
+// Reduce folds the values into a single result.
+//
+// Deprecated: use stream.Reduce.
 func Reduce[T comparable, V comparable](it *Iterator[T], initial V, reducer func(V, T) V) V {
 	acc := initial
 	for it.HasNext() {
@@ -41,6 +76,10 @@ func Reduce[T comparable, V comparable](it *Iterator[T], initial V, reducer func
 	return acc
 }
 
+// Filter builds a Queue of the values that match the predicate.
+//
+// Deprecated: use Stream.Filter, which skips values lazily instead of copying
+// the matches into a new Queue.
 func Filter[T comparable](it *Iterator[T], predicate func(T) bool) *Queue[T] {
 	filtered := NewQueue[T]()
 	for it.HasNext() {
@@ -51,3 +90,36 @@ func Filter[T comparable](it *Iterator[T], predicate func(T) bool) *Queue[T] {
 	}
 	return filtered
 }
+
+// DescendingIterator walks a Queue or an ArrayQueue from tail to head, newest
+// value first. It fails fast like Iterator, and each step is O(1).
+type DescendingIterator[T comparable] struct {
+	// it is the ReverseIterator of the embedded list.LinkedList or
+	// deque.ArrayDeque.
+	it collection.Iterator[T]
+}
+
+func (s *Queue[T]) DescendingIterator() *DescendingIterator[T] {
+	return &DescendingIterator[T]{s.LinkedList.ReverseIterator()}
+}
+
+func (it *DescendingIterator[T]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+// Next returns the next value, or the zero value if there is none or the
+// queue was modified.
+func (it *DescendingIterator[T]) Next() T {
+	return it.it.Next()
+}
+
+// Remove removes the value last returned by Next.
+func (it *DescendingIterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the queue.
+func (it *DescendingIterator[T]) Err() error {
+	return it.it.Err()
+}
@@ -1,6 +1,10 @@
 package queue
 
 import (
+	"go-utils/array"
+	"go-utils/collection"
+	"go-utils/list"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -94,14 +98,152 @@ func TestQueue_Filter(t *testing.T) {
 	validateQueuePoll(t, filtered, 4)
 }
 
-func validateQueuePoll[T comparable](t *testing.T, queue *Queue[T], expectedValue T) {
+func validateQueuePoll[T comparable](t *testing.T, queue collection.Queue[T], expectedValue T) {
 	value, err := queue.Poll()
 	require.Nil(t, err, "Queue poll is failed")
 	require.Equal(t, expectedValue, value, "Queue poll item is not matched")
 }
 
-func validateQueuePeek[T comparable](t *testing.T, queue *Queue[T], expectedValue T) {
+func validateQueuePeek[T comparable](t *testing.T, queue collection.Queue[T], expectedValue T) {
 	value, err := queue.Peek()
 	require.Nil(t, err, "Queue peek is failed")
 	require.Equal(t, expectedValue, value, "Queue peek item is not matched")
 }
+
+// iterableQueue is implemented by both Queue and ArrayQueue.
+type iterableQueue interface {
+	collection.Queue[int]
+	Iterator() *Iterator[int]
+	DescendingIterator() *DescendingIterator[int]
+}
+
+func TestQueue_All(t *testing.T) {
+	queue := Collect(slices.Values([]int{1, 2, 3, 4}))
+	require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(queue.All()), "Queue all is failed")
+	require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(queue.Backward()), "Queue backward is failed")
+	validateQueuePeek(t, queue, 1)
+}
+
+func TestArrayQueue(t *testing.T) {
+	queue := NewArrayQueue[int]()
+	queue.OfferValues([]int{1, 2, 3, 4})
+	validateQueuePeek(t, queue, 1)
+	validateQueuePoll(t, queue, 1)
+
+	clone := queue.Clone()
+	validateQueuePoll(t, clone, 2)
+	require.Equal(t, []int{2, 3, 4}, queue.Values(), "ArrayQueue clone is not a copy")
+	require.Equal(t, []int{3, 4}, clone.Values(), "ArrayQueue clone values are not equal")
+
+	iterated := []int{}
+	queue.Iterator().Each(func(value int) {
+		iterated = append(iterated, value)
+	})
+	require.Equal(t, []int{2, 3, 4}, iterated, "ArrayQueue iterator is not matched")
+}
+
+func TestQueue_Clone(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.OfferValues([]int{1, 2, 3})
+	clone := queue.Clone()
+	value, err := clone.RemoveHead()
+	require.Nil(t, err, "Queue clone remove head is failed")
+	require.Equal(t, 1, value, "Queue clone head is not matched")
+	require.Equal(t, []int{1, 2, 3}, queue.Values(), "Queue clone is not a copy")
+}
+
+func TestQueue_Merge(t *testing.T) {
+	queue := NewQueue[int]()
+	queue.Offer(1)
+	other := list.NewLinkedList[int]()
+	other.AddAll([]int{2, 3})
+	queue.Merge(other)
+	arr := array.NewArrayList[int]()
+	arr.Add(4)
+	queue.MergeArray(arr)
+	require.Equal(t, []int{1, 2, 3, 4}, queue.LinkedList.Values(), "Queue merge values are not equal")
+	validateQueuePoll(t, queue, 1)
+}
+
+func BenchmarkQueue_Iterator(b *testing.B) {
+	for _, bench := range []struct {
+		name  string
+		queue iterableQueue
+	}{
+		{"LinkedList", NewQueue[int]()},
+		{"ArrayDeque", NewArrayQueue[int]()},
+	} {
+		for i := 0; i < 1000; i++ {
+			bench.queue.Offer(i)
+		}
+
+		b.Run(bench.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bench.queue.Iterator().Each(func(int) {})
+			}
+		})
+	}
+}
+
+func BenchmarkQueue_DescendingIterator(b *testing.B) {
+	queue := NewQueue[int]()
+	for i := 0; i < 1000; i++ {
+		queue.Offer(i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		it := queue.DescendingIterator()
+		for it.HasNext() {
+			it.Next()
+		}
+	}
+}
+
+func TestQueue_IteratorRemove(t *testing.T) {
+	for name, queue := range map[string]iterableQueue{"LinkedList": NewQueue[int](), "ArrayDeque": NewArrayQueue[int]()} {
+		queue.OfferValues([]int{1, 2, 3, 4, 5, 6})
+
+		it := queue.Iterator()
+		require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, name+" queue iterator remove before next is not failed")
+		visited := []int{}
+		for it.HasNext() {
+			value := it.Next()
+			visited = append(visited, value)
+			if value%3 == 0 {
+				require.Nil(t, it.Remove(), name+" queue iterator remove is failed")
+			}
+		}
+		require.Equal(t, []int{1, 2, 3, 4, 5, 6}, visited, name+" queue iterator values are not matched")
+		require.Equal(t, []int{1, 2, 4, 5}, queue.Values(), name+" queue values are not equal")
+
+		it = queue.Iterator()
+		it.Next()
+		queue.Poll()
+		require.False(t, it.HasNext(), name+" queue iterator has next after poll is not matched")
+		require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, name+" queue iterator error is not matched")
+	}
+}
+
+func TestQueue_DescendingIterator(t *testing.T) {
+	for name, queue := range map[string]iterableQueue{"LinkedList": NewQueue[int](), "ArrayDeque": NewArrayQueue[int]()} {
+		queue.OfferValues([]int{1, 2, 3, 4})
+
+		it := queue.DescendingIterator()
+		values := []int{}
+		for it.HasNext() {
+			value := it.Next()
+			values = append(values, value)
+			if value == 3 {
+				require.Nil(t, it.Remove(), name+" queue descending iterator remove is failed")
+				require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, name+" queue descending iterator remove twice is not failed")
+			}
+		}
+		require.Equal(t, []int{4, 3, 2, 1}, values, name+" queue descending iterator values are not matched")
+		require.Equal(t, []int{1, 2, 4}, queue.Values(), name+" queue values are not equal")
+
+		it = queue.DescendingIterator()
+		queue.Offer(5)
+		require.False(t, it.HasNext(), name+" queue descending iterator has next after offer is not matched")
+		require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, name+" queue descending iterator error is not matched")
+	}
+}
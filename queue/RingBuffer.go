@@ -0,0 +1,147 @@
+package queue
+
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+var _ collection.Collection[int] = (*RingBuffer[int])(nil)
+
+// ErrBufferFull is the collection.ErrCapacityExceeded of a full ring buffer.
+var ErrBufferFull = collection.ErrCapacityExceeded
+
+// FullPolicy decides what a ring buffer does with a value offered while full.
+type FullPolicy int
+
+const (
+	// Overwrite evicts the oldest value to make room.
+	Overwrite FullPolicy = iota
+	// Reject refuses the new value.
+	Reject
+	// Block makes ConcurrentRingBuffer.Put wait for space. A RingBuffer has
+	// nobody to wait for and rejects instead.
+	Block
+)
+
+// RingBuffer keeps at most capacity values in a fixed circular buffer,
+// typically the last N events of a stream. Values, All and Latest go from
+// oldest to newest.
+type RingBuffer[T comparable] struct {
+	items  []T
+	head   int
+	size   int
+	policy FullPolicy
+}
+
+// NewRingBuffer creates a ring buffer holding up to capacity values, at least one.
+func NewRingBuffer[T comparable](capacity int, policy FullPolicy) *RingBuffer[T] {
+	return &RingBuffer[T]{items: make([]T, max(capacity, 1)), policy: policy}
+}
+
+func (q *RingBuffer[T]) Size() int {
+	return q.size
+}
+
+func (q *RingBuffer[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+func (q *RingBuffer[T]) IsFull() bool {
+	return q.size == len(q.items)
+}
+
+func (q *RingBuffer[T]) Capacity() int {
+	return len(q.items)
+}
+
+func (q *RingBuffer[T]) Values() []T {
+	return q.Latest(q.size)
+}
+
+func (q *RingBuffer[T]) Clear() {
+	clear(q.items)
+	q.head = 0
+	q.size = 0
+}
+
+func (q *RingBuffer[T]) Contains(value T) bool {
+	for i := 0; i < q.size; i++ {
+		if q.items[q.index(i)] == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Offer adds the value as the newest one. When the buffer is full it evicts
+// the oldest value under the Overwrite policy and otherwise returns false.
+func (q *RingBuffer[T]) Offer(value T) bool {
+	if q.IsFull() {
+		if q.policy != Overwrite {
+			return false
+		}
+		q.head = q.index(1)
+		q.size--
+	}
+
+	q.items[q.index(q.size)] = value
+	q.size++
+	return true
+}
+
+// Poll removes the oldest value.
+func (q *RingBuffer[T]) Poll() (T, error) {
+	value, err := q.Peek()
+	if err != nil {
+		return value, err
+	}
+
+	var zero T
+	q.items[q.head] = zero
+	q.head = q.index(1)
+	q.size--
+	return value, nil
+}
+
+// Peek returns the oldest value.
+func (q *RingBuffer[T]) Peek() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.items[q.head], nil
+}
+
+// PeekNewest returns the most recently offered value.
+func (q *RingBuffer[T]) PeekNewest() (T, error) {
+	if q.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return q.items[q.index(q.size-1)], nil
+}
+
+// Latest returns the n most recent values, oldest first.
+func (q *RingBuffer[T]) Latest(n int) []T {
+	n = min(max(n, 0), q.size)
+	values := make([]T, n)
+	for i := range values {
+		values[i] = q.items[q.index(q.size-n+i)]
+	}
+	return values
+}
+
+// All yields the values from oldest to newest.
+func (q *RingBuffer[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < q.size; i++ {
+			if !yield(q.items[q.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (q *RingBuffer[T]) index(i int) int {
+	return (q.head + i) % len(q.items)
+}
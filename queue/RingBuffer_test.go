@@ -0,0 +1,53 @@
+package queue
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRingBuffer_Overwrite(t *testing.T) {
+	buffer := NewRingBuffer[int](3, Overwrite)
+	require.True(t, buffer.IsEmpty(), "RingBuffer is not empty")
+	_, err := buffer.Peek()
+	require.NotNil(t, err, "RingBuffer peek is not failed")
+
+	for i := 1; i <= 5; i++ {
+		require.True(t, buffer.Offer(i), "RingBuffer offer is failed")
+	}
+	require.True(t, buffer.IsFull(), "RingBuffer is not full")
+	require.Equal(t, 3, buffer.Size(), "RingBuffer size is not equal")
+	require.Equal(t, []int{3, 4, 5}, buffer.Values(), "RingBuffer values are not equal")
+	require.Equal(t, []int{3, 4, 5}, slices.Collect(buffer.All()), "RingBuffer all is not matched")
+	require.Equal(t, []int{4, 5}, buffer.Latest(2), "RingBuffer latest(2) is not matched")
+	require.Equal(t, []int{3, 4, 5}, buffer.Latest(10), "RingBuffer latest(10) is not matched")
+	require.Empty(t, buffer.Latest(0), "RingBuffer latest(0) is not empty")
+	require.False(t, buffer.Contains(2), "RingBuffer contains(2) is not matched")
+
+	newest, _ := buffer.PeekNewest()
+	require.Equal(t, 5, newest, "RingBuffer peek newest is not matched")
+	value, err := buffer.Poll()
+	require.Nil(t, err, "RingBuffer poll is failed")
+	require.Equal(t, 3, value, "RingBuffer poll is not matched")
+
+	buffer.Offer(6)
+	buffer.Offer(7)
+	require.Equal(t, []int{5, 6, 7}, buffer.Values(), "RingBuffer values are not equal")
+
+	buffer.Clear()
+	require.True(t, buffer.IsEmpty(), "RingBuffer is not empty")
+	require.Equal(t, []int{}, buffer.Values(), "RingBuffer values are not empty")
+}
+
+func TestRingBuffer_Reject(t *testing.T) {
+	for _, policy := range []FullPolicy{Reject, Block} {
+		buffer := NewRingBuffer[int](2, policy)
+		require.True(t, buffer.Offer(1), "RingBuffer offer is failed")
+		require.True(t, buffer.Offer(2), "RingBuffer offer is failed")
+		require.False(t, buffer.Offer(3), "RingBuffer offer over capacity is not rejected")
+		require.Equal(t, []int{1, 2}, buffer.Values(), "RingBuffer values are not equal")
+	}
+
+	require.Equal(t, 1, NewRingBuffer[int](0, Reject).Capacity(), "RingBuffer minimum capacity is not matched")
+}
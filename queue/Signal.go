@@ -0,0 +1,41 @@
+package queue
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// signal wakes up every goroutine waiting for a change to state guarded by a
+// mutex. Unlike sync.Cond the wait can also end on a context or a timer.
+type signal struct {
+	changed chan struct{}
+}
+
+func newSignal() signal {
+	return signal{changed: make(chan struct{})}
+}
+
+// broadcast must be called with the mutex held.
+func (s *signal) broadcast() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// wait must be called with mu held. It releases mu until broadcast is called,
+// ctx is done or timeout fires (a nil timeout never fires). mu is held again
+// when it returns nil and released when it returns ctx's error.
+func (s *signal) wait(ctx context.Context, mu sync.Locker, timeout <-chan time.Time) error {
+	changed := s.changed
+	mu.Unlock()
+
+	select {
+	case <-changed:
+	case <-timeout:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	mu.Lock()
+	return nil
+}
@@ -0,0 +1,120 @@
+package set
+
+import (
+	"go-utils/collection"
+	"go-utils/maps"
+	"iter"
+)
+
+var _ collection.Set[int] = (*HashSet[int])(nil)
+
+// HashSet is a Set backed by a maps.HashMap; iteration order is unspecified.
+type HashSet[T comparable] struct {
+	items *maps.HashMap[T, struct{}]
+}
+
+func NewHashSet[T comparable]() *HashSet[T] {
+	return &HashSet[T]{items: maps.NewHashMap[T, struct{}]()}
+}
+
+// NewHashSetFrom creates a set holding the distinct values, for example the
+// Values() of another collection.
+func NewHashSetFrom[T comparable](values []T) *HashSet[T] {
+	s := NewHashSet[T]()
+	s.AddAll(values)
+	return s
+}
+
+func Collect[T comparable](seq iter.Seq[T]) *HashSet[T] {
+	s := NewHashSet[T]()
+	for value := range seq {
+		s.Add(value)
+	}
+	return s
+}
+
+func (s *HashSet[T]) Size() int {
+	return s.items.Size()
+}
+
+func (s *HashSet[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *HashSet[T]) Values() []T {
+	values := make([]T, 0, s.Size())
+	for value := range s.items.Keys() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (s *HashSet[T]) Clear() {
+	s.items.Clear()
+}
+
+func (s *HashSet[T]) Contains(value T) bool {
+	return s.items.ContainsKey(value)
+}
+
+func (s *HashSet[T]) Add(value T) bool {
+	_, loaded := s.items.PutIfAbsent(value, struct{}{})
+	return !loaded
+}
+
+func (s *HashSet[T]) AddAll(values []T) {
+	for _, value := range values {
+		s.Add(value)
+	}
+}
+
+func (s *HashSet[T]) Remove(value T) bool {
+	_, ok := s.items.Remove(value)
+	return ok
+}
+
+func (s *HashSet[T]) All() iter.Seq[T] {
+	return s.items.Keys()
+}
+
+func (s *HashSet[T]) Clone() *HashSet[T] {
+	return &HashSet[T]{items: s.items.Clone()}
+}
+
+func (s *HashSet[T]) Union(other collection.Set[T]) *HashSet[T] {
+	result := s.Clone()
+	addAll(result, other)
+	return result
+}
+
+func (s *HashSet[T]) Intersection(other collection.Set[T]) *HashSet[T] {
+	result := NewHashSet[T]()
+	addIf(result, s, other.Contains)
+	return result
+}
+
+// Difference returns the values of s that are not in other.
+func (s *HashSet[T]) Difference(other collection.Set[T]) *HashSet[T] {
+	result := NewHashSet[T]()
+	addIf(result, s, func(value T) bool { return !other.Contains(value) })
+	return result
+}
+
+// SymmetricDifference returns the values that are in exactly one of the sets.
+func (s *HashSet[T]) SymmetricDifference(other collection.Set[T]) *HashSet[T] {
+	result := s.Difference(other)
+	addIf(result, other, func(value T) bool { return !s.Contains(value) })
+	return result
+}
+
+func (s *HashSet[T]) IsSubset(other collection.Set[T]) bool {
+	return isSubset(s, other)
+}
+
+func (s *HashSet[T]) IsSuperset(other collection.Set[T]) bool {
+	return isSubset(other, s)
+}
+
+func (s *HashSet[T]) Disjoint(other collection.Set[T]) bool {
+	return disjoint(s, other)
+}
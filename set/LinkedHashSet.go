@@ -0,0 +1,114 @@
+package set
+
+import (
+	"go-utils/collection"
+	"go-utils/maps"
+	"iter"
+)
+
+var _ collection.Set[int] = (*LinkedHashSet[int])(nil)
+
+// LinkedHashSet is a Set that iterates in insertion order. Adding a value that
+// is already present does not move it.
+type LinkedHashSet[T comparable] struct {
+	items *maps.LinkedHashMap[T, struct{}]
+}
+
+func NewLinkedHashSet[T comparable]() *LinkedHashSet[T] {
+	return &LinkedHashSet[T]{items: maps.NewLinkedHashMap[T, struct{}]()}
+}
+
+// NewLinkedHashSetFrom creates a set holding the distinct values in their
+// first-seen order, which makes it a stable way to dedupe a slice.
+func NewLinkedHashSetFrom[T comparable](values []T) *LinkedHashSet[T] {
+	s := NewLinkedHashSet[T]()
+	s.AddAll(values)
+	return s
+}
+
+func (s *LinkedHashSet[T]) Size() int {
+	return s.items.Size()
+}
+
+func (s *LinkedHashSet[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *LinkedHashSet[T]) Values() []T {
+	values := make([]T, 0, s.Size())
+	for value := range s.items.Keys() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (s *LinkedHashSet[T]) Clear() {
+	s.items.Clear()
+}
+
+func (s *LinkedHashSet[T]) Contains(value T) bool {
+	return s.items.ContainsKey(value)
+}
+
+func (s *LinkedHashSet[T]) Add(value T) bool {
+	_, loaded := s.items.PutIfAbsent(value, struct{}{})
+	return !loaded
+}
+
+func (s *LinkedHashSet[T]) AddAll(values []T) {
+	for _, value := range values {
+		s.Add(value)
+	}
+}
+
+func (s *LinkedHashSet[T]) Remove(value T) bool {
+	_, ok := s.items.Remove(value)
+	return ok
+}
+
+func (s *LinkedHashSet[T]) All() iter.Seq[T] {
+	return s.items.Keys()
+}
+
+func (s *LinkedHashSet[T]) Clone() *LinkedHashSet[T] {
+	return NewLinkedHashSetFrom(s.Values())
+}
+
+func (s *LinkedHashSet[T]) Union(other collection.Set[T]) *LinkedHashSet[T] {
+	result := s.Clone()
+	addAll(result, other)
+	return result
+}
+
+func (s *LinkedHashSet[T]) Intersection(other collection.Set[T]) *LinkedHashSet[T] {
+	result := NewLinkedHashSet[T]()
+	addIf(result, s, other.Contains)
+	return result
+}
+
+// Difference returns the values of s that are not in other.
+func (s *LinkedHashSet[T]) Difference(other collection.Set[T]) *LinkedHashSet[T] {
+	result := NewLinkedHashSet[T]()
+	addIf(result, s, func(value T) bool { return !other.Contains(value) })
+	return result
+}
+
+// SymmetricDifference returns the values that are in exactly one of the sets,
+// those of s first.
+func (s *LinkedHashSet[T]) SymmetricDifference(other collection.Set[T]) *LinkedHashSet[T] {
+	result := s.Difference(other)
+	addIf(result, other, func(value T) bool { return !s.Contains(value) })
+	return result
+}
+
+func (s *LinkedHashSet[T]) IsSubset(other collection.Set[T]) bool {
+	return isSubset(s, other)
+}
+
+func (s *LinkedHashSet[T]) IsSuperset(other collection.Set[T]) bool {
+	return isSubset(other, s)
+}
+
+func (s *LinkedHashSet[T]) Disjoint(other collection.Set[T]) bool {
+	return disjoint(s, other)
+}
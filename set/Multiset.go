@@ -0,0 +1,160 @@
+package set
+
+import (
+	"go-utils/collection"
+	"go-utils/maps"
+	"iter"
+	"slices"
+)
+
+var _ collection.Collection[int] = (*Multiset[int])(nil)
+
+// MultisetEntry is a distinct value of a Multiset with its number of
+// occurrences.
+type MultisetEntry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// Multiset (or bag) is a collection that counts occurrences of its values.
+// Size and Values count every occurrence; ElementSet holds each value once.
+// Distinct values are kept in first-seen order, which breaks ties in EntrySet
+// and TopK.
+type Multiset[T comparable] struct {
+	counts *maps.LinkedHashMap[T, int]
+	size   int
+}
+
+func NewMultiset[T comparable]() *Multiset[T] {
+	return &Multiset[T]{counts: maps.NewLinkedHashMap[T, int]()}
+}
+
+func NewMultisetFrom[T comparable](values []T) *Multiset[T] {
+	s := NewMultiset[T]()
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+func (s *Multiset[T]) Size() int {
+	return s.size
+}
+
+func (s *Multiset[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Values returns every occurrence, grouped by value.
+func (s *Multiset[T]) Values() []T {
+	values := make([]T, 0, s.size)
+	for value := range s.All() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (s *Multiset[T]) Clear() {
+	s.counts.Clear()
+	s.size = 0
+}
+
+func (s *Multiset[T]) Contains(value T) bool {
+	return s.counts.ContainsKey(value)
+}
+
+func (s *Multiset[T]) Count(value T) int {
+	return s.counts.GetOrDefault(value, 0)
+}
+
+func (s *Multiset[T]) Add(value T) int {
+	return s.AddCount(value, 1)
+}
+
+// AddCount adds count occurrences of value and returns its new count. A
+// count less than one adds nothing.
+func (s *Multiset[T]) AddCount(value T, count int) int {
+	if count <= 0 {
+		return s.Count(value)
+	}
+
+	s.size += count
+	total, _ := s.counts.Merge(value, count, func(current, added int) (int, bool) {
+		return current + added, true
+	})
+	return total
+}
+
+func (s *Multiset[T]) Remove(value T) bool {
+	return s.RemoveCount(value, 1) == 1
+}
+
+// RemoveCount removes up to count occurrences of value and returns how many
+// were removed.
+func (s *Multiset[T]) RemoveCount(value T, count int) int {
+	current := s.Count(value)
+	removed := min(max(count, 0), current)
+	s.SetCount(value, current-removed)
+	return removed
+}
+
+// SetCount sets the number of occurrences of value, removing it when count is
+// zero or less, and returns the previous count.
+func (s *Multiset[T]) SetCount(value T, count int) int {
+	previous := s.Count(value)
+	if count <= 0 {
+		s.counts.Remove(value)
+		count = 0
+	} else {
+		s.counts.Put(value, count)
+	}
+
+	s.size += count - previous
+	return previous
+}
+
+// ElementSet returns the distinct values in first-seen order.
+func (s *Multiset[T]) ElementSet() *LinkedHashSet[T] {
+	elements := NewLinkedHashSet[T]()
+	for value := range s.counts.Keys() {
+		elements.Add(value)
+	}
+	return elements
+}
+
+// EntrySet returns the distinct values with their counts, most frequent first.
+func (s *Multiset[T]) EntrySet() []MultisetEntry[T] {
+	entries := make([]MultisetEntry[T], 0, s.counts.Size())
+	for value, count := range s.counts.Entries() {
+		entries = append(entries, MultisetEntry[T]{Value: value, Count: count})
+	}
+
+	slices.SortStableFunc(entries, func(a, b MultisetEntry[T]) int {
+		return b.Count - a.Count
+	})
+	return entries
+}
+
+// TopK returns the k most frequent values with their counts.
+func (s *Multiset[T]) TopK(k int) []MultisetEntry[T] {
+	entries := s.EntrySet()
+	return entries[:min(max(k, 0), len(entries))]
+}
+
+// All yields every occurrence, grouped by value.
+func (s *Multiset[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value, count := range s.counts.Entries() {
+			for i := 0; i < count; i++ {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Entries yields each distinct value with its count in first-seen order.
+func (s *Multiset[T]) Entries() iter.Seq2[T, int] {
+	return s.counts.Entries()
+}
@@ -0,0 +1,41 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiset(t *testing.T) {
+	words := NewMultisetFrom([]string{"b", "a", "c", "a", "b", "a"})
+	require.Equal(t, 6, words.Size(), "Multiset size is not equal")
+	require.Equal(t, 3, words.Count("a"), "Multiset count(a) is not matched")
+	require.Equal(t, 0, words.Count("z"), "Multiset count(z) is not matched")
+	require.Equal(t, []string{"b", "b", "a", "a", "a", "c"}, words.Values(), "Multiset values are not matched")
+
+	require.Equal(t, 5, words.AddCount("c", 4), "Multiset add count(c) is not matched")
+	require.Equal(t, 10, words.Size(), "Multiset size is not equal")
+	require.Equal(t, 3, words.Add("b"), "Multiset add(b) is not matched")
+
+	require.Equal(t, []MultisetEntry[string]{{"c", 5}, {"b", 3}, {"a", 3}}, words.EntrySet(), "Multiset entry set is not matched")
+	require.Equal(t, []MultisetEntry[string]{{"c", 5}, {"b", 3}}, words.TopK(2), "Multiset top 2 is not matched")
+	require.Len(t, words.TopK(10), 3, "Multiset top 10 is not matched")
+	require.Empty(t, words.TopK(0), "Multiset top 0 is not empty")
+	require.Equal(t, []string{"b", "a", "c"}, words.ElementSet().Values(), "Multiset element set is not matched")
+
+	require.Equal(t, 2, words.RemoveCount("a", 2), "Multiset remove count(a) is not matched")
+	require.Equal(t, 1, words.Count("a"), "Multiset count(a) is not matched")
+	require.Equal(t, 1, words.RemoveCount("a", 5), "Multiset remove count(a) is not matched")
+	require.False(t, words.Contains("a"), "Multiset contains(a) is not matched")
+	require.False(t, words.Remove("a"), "Multiset remove(a) is not matched")
+	require.True(t, words.Remove("b"), "Multiset remove(b) is not matched")
+
+	require.Equal(t, 5, words.SetCount("c", 1), "Multiset set count(c) is not matched")
+	require.Equal(t, 3, words.Size(), "Multiset size is not equal")
+	words.SetCount("b", 0)
+	require.Equal(t, []string{"c"}, words.ElementSet().Values(), "Multiset element set is not matched")
+
+	words.Clear()
+	require.True(t, words.IsEmpty(), "Multiset is not empty")
+	require.Empty(t, words.EntrySet(), "Multiset entry set is not empty")
+}
@@ -0,0 +1,47 @@
+package set
+
+import "go-utils/collection"
+
+// The algebra methods of every set accept any collection.Set, so sets of
+// different kinds can be combined. The result has the kind of the receiver
+// and, for ordered sets, follows the receiver's order.
+
+func isSubset[T comparable](a, b collection.Set[T]) bool {
+	if a.Size() > b.Size() {
+		return false
+	}
+
+	for value := range a.All() {
+		if !b.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func disjoint[T comparable](a, b collection.Set[T]) bool {
+	if a.Size() > b.Size() {
+		a, b = b, a
+	}
+
+	for value := range a.All() {
+		if b.Contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func addAll[T comparable](dest collection.Set[T], src collection.Set[T]) {
+	for value := range src.All() {
+		dest.Add(value)
+	}
+}
+
+func addIf[T comparable](dest collection.Set[T], src collection.Set[T], predicate func(T) bool) {
+	for value := range src.All() {
+		if predicate(value) {
+			dest.Add(value)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package set
+
+import (
+	"go-utils/array"
+	"go-utils/list"
+	"go-utils/queue"
+	"go-utils/stack"
+	"go-utils/tree"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intComparator(a, b int) int { return a - b }
+
+func TestHashSet_Algebra(t *testing.T) {
+	a := NewHashSetFrom([]int{1, 2, 3, 4})
+	b := NewHashSetFrom([]int{3, 4, 5})
+
+	require.ElementsMatch(t, []int{1, 2, 3, 4, 5}, a.Union(b).Values(), "HashSet union is not matched")
+	require.ElementsMatch(t, []int{3, 4}, a.Intersection(b).Values(), "HashSet intersection is not matched")
+	require.ElementsMatch(t, []int{1, 2}, a.Difference(b).Values(), "HashSet difference is not matched")
+	require.ElementsMatch(t, []int{1, 2, 5}, a.SymmetricDifference(b).Values(), "HashSet symmetric difference is not matched")
+	require.ElementsMatch(t, []int{1, 2, 3, 4}, a.Values(), "HashSet receiver is modified")
+
+	require.True(t, NewHashSetFrom([]int{3, 4}).IsSubset(a), "HashSet is subset is not matched")
+	require.False(t, b.IsSubset(a), "HashSet is subset is not matched")
+	require.True(t, a.IsSuperset(NewHashSetFrom([]int{1, 4})), "HashSet is superset is not matched")
+	require.True(t, NewHashSet[int]().IsSubset(a), "HashSet empty is subset is not matched")
+	require.False(t, a.Disjoint(b), "HashSet disjoint is not matched")
+	require.True(t, a.Disjoint(NewHashSetFrom([]int{7, 8})), "HashSet disjoint is not matched")
+}
+
+func TestLinkedHashSet_Order(t *testing.T) {
+	a := NewLinkedHashSetFrom([]int{5, 3, 5, 1, 3})
+	require.Equal(t, []int{5, 3, 1}, a.Values(), "LinkedHashSet values are not in insertion order")
+	require.False(t, a.Add(5), "LinkedHashSet add(5) is not matched")
+	require.Equal(t, []int{5, 3, 1}, a.Values(), "LinkedHashSet values are not in insertion order")
+
+	b := NewLinkedHashSetFrom([]int{4, 1, 6})
+	require.Equal(t, []int{5, 3, 1, 4, 6}, a.Union(b).Values(), "LinkedHashSet union is not matched")
+	require.Equal(t, []int{1}, a.Intersection(b).Values(), "LinkedHashSet intersection is not matched")
+	require.Equal(t, []int{5, 3}, a.Difference(b).Values(), "LinkedHashSet difference is not matched")
+	require.Equal(t, []int{5, 3, 4, 6}, a.SymmetricDifference(b).Values(), "LinkedHashSet symmetric difference is not matched")
+}
+
+func TestSortedSet_Order(t *testing.T) {
+	a := NewSortedSetFrom([]int{5, 3, 5, 1, 3}, intComparator)
+	require.Equal(t, []int{1, 3, 5}, a.Values(), "SortedSet values are not sorted")
+	first, _ := a.First()
+	require.Equal(t, 1, first, "SortedSet first is not matched")
+	last, _ := a.Last()
+	require.Equal(t, 5, last, "SortedSet last is not matched")
+
+	// other kinds of sets can be combined; the result keeps the receiver's kind
+	b := NewHashSetFrom([]int{4, 1, 6})
+	require.Equal(t, []int{1, 3, 4, 5, 6}, a.Union(b).Values(), "SortedSet union is not matched")
+	require.Equal(t, []int{1}, a.Intersection(b).Values(), "SortedSet intersection is not matched")
+	require.Equal(t, []int{3, 5}, a.Difference(b).Values(), "SortedSet difference is not matched")
+	require.Equal(t, []int{3, 4, 5, 6}, a.SymmetricDifference(b).Values(), "SortedSet symmetric difference is not matched")
+	require.True(t, NewHashSetFrom([]int{5, 1}).IsSubset(a), "HashSet is subset of SortedSet is not matched")
+}
+
+func TestSet_Conversions(t *testing.T) {
+	arr := array.NewArrayList[int]()
+	arr.AddAll([]int{3, 1, 3, 2})
+	ll := list.NewLinkedList[int]()
+	ll.AddAll([]int{3, 1, 3, 2})
+	q := queue.NewQueue[int]()
+	q.OfferValues([]int{3, 1, 3, 2})
+	st := stack.NewStack[int]()
+	st.PushValues([]int{3, 1, 3, 2})
+	bt := tree.NewBinaryTree[int](intComparator)
+	bt.OfferAll([]int{3, 1, 3, 2})
+
+	for _, values := range [][]int{arr.Values(), ll.Values(), q.Values(), st.Values(), bt.Values()} {
+		require.ElementsMatch(t, []int{1, 2, 3}, NewHashSetFrom(values).Values(), "HashSet from values is not matched")
+		require.Equal(t, []int{1, 2, 3}, NewSortedSetFrom(values, intComparator).Values(), "SortedSet from values is not matched")
+	}
+	require.Equal(t, []int{3, 1, 2}, NewLinkedHashSetFrom(arr.Values()).Values(), "LinkedHashSet from values is not matched")
+	require.ElementsMatch(t, []int{1, 2, 3}, Collect(arr.All()).Values(), "HashSet collect is not matched")
+
+	sorted := NewSortedSetFrom([]int{3, 1, 2}, intComparator)
+	require.Equal(t, []int{1, 2, 3}, array.Collect(sorted.All()).Values(), "SortedSet to array is not matched")
+	require.Equal(t, []int{1, 2, 3}, list.Collect(sorted.All()).Values(), "SortedSet to list is not matched")
+	require.Equal(t, []int{1, 2, 3}, queue.Collect(sorted.All()).Values(), "SortedSet to queue is not matched")
+	require.Equal(t, []int{1, 2, 3}, tree.Collect(sorted.All(), intComparator).Values(), "SortedSet to tree is not matched")
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(sorted.All()), "SortedSet to slice is not matched")
+}
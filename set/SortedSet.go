@@ -0,0 +1,118 @@
+package set
+
+import (
+	"go-utils/collection"
+	"go-utils/tree"
+	"iter"
+)
+
+var _ collection.Set[int] = (*SortedSet[int])(nil)
+
+// SortedSet is a Set that iterates in comparator order, backed by a balanced
+// tree.TreeSet. The comparator has the same form as for tree.NewBinaryTree.
+type SortedSet[T comparable] struct {
+	items      *tree.TreeSet[T]
+	comparator func(a, b T) int
+}
+
+func NewSortedSet[T comparable](comparator func(a, b T) int) *SortedSet[T] {
+	return &SortedSet[T]{items: tree.NewTreeSet[T](comparator), comparator: comparator}
+}
+
+// NewSortedSetFrom creates a set holding the distinct values in sorted order.
+func NewSortedSetFrom[T comparable](values []T, comparator func(a, b T) int) *SortedSet[T] {
+	s := NewSortedSet[T](comparator)
+	s.AddAll(values)
+	return s
+}
+
+func (s *SortedSet[T]) Size() int {
+	return s.items.Size()
+}
+
+func (s *SortedSet[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *SortedSet[T]) Values() []T {
+	return s.items.Values()
+}
+
+func (s *SortedSet[T]) Clear() {
+	s.items.Clear()
+}
+
+func (s *SortedSet[T]) Contains(value T) bool {
+	return s.items.Contains(value)
+}
+
+func (s *SortedSet[T]) Add(value T) bool {
+	if s.items.Contains(value) {
+		return false
+	}
+
+	s.items.Offer(value)
+	return true
+}
+
+func (s *SortedSet[T]) AddAll(values []T) {
+	s.items.OfferAll(values)
+}
+
+func (s *SortedSet[T]) Remove(value T) bool {
+	return s.items.Remove(value)
+}
+
+func (s *SortedSet[T]) All() iter.Seq[T] {
+	return s.items.All()
+}
+
+func (s *SortedSet[T]) First() (T, bool) {
+	return s.items.First()
+}
+
+func (s *SortedSet[T]) Last() (T, bool) {
+	return s.items.Last()
+}
+
+func (s *SortedSet[T]) Clone() *SortedSet[T] {
+	return NewSortedSetFrom(s.Values(), s.comparator)
+}
+
+func (s *SortedSet[T]) Union(other collection.Set[T]) *SortedSet[T] {
+	result := s.Clone()
+	addAll(result, other)
+	return result
+}
+
+func (s *SortedSet[T]) Intersection(other collection.Set[T]) *SortedSet[T] {
+	result := NewSortedSet[T](s.comparator)
+	addIf(result, s, other.Contains)
+	return result
+}
+
+// Difference returns the values of s that are not in other.
+func (s *SortedSet[T]) Difference(other collection.Set[T]) *SortedSet[T] {
+	result := NewSortedSet[T](s.comparator)
+	addIf(result, s, func(value T) bool { return !other.Contains(value) })
+	return result
+}
+
+// SymmetricDifference returns the values that are in exactly one of the sets.
+func (s *SortedSet[T]) SymmetricDifference(other collection.Set[T]) *SortedSet[T] {
+	result := s.Difference(other)
+	addIf(result, other, func(value T) bool { return !s.Contains(value) })
+	return result
+}
+
+func (s *SortedSet[T]) IsSubset(other collection.Set[T]) bool {
+	return isSubset(s, other)
+}
+
+func (s *SortedSet[T]) IsSuperset(other collection.Set[T]) bool {
+	return isSubset(other, s)
+}
+
+func (s *SortedSet[T]) Disjoint(other collection.Set[T]) bool {
+	return disjoint(s, other)
+}
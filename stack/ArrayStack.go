@@ -0,0 +1,57 @@
+package stack
+
+import (
+	"go-utils/collection"
+	"go-utils/deque"
+)
+
+var (
+	_ collection.Stack[int] = (*ArrayStack[int])(nil)
+	_ collection.Deque[int] = (*ArrayStack[int])(nil)
+)
+
+// ArrayStack is a Stack backed by a deque.ArrayDeque instead of a
+// list.LinkedList, which avoids an allocation per value.
+type ArrayStack[T comparable] struct {
+	*deque.ArrayDeque[T]
+}
+
+func NewArrayStack[T comparable]() *ArrayStack[T] {
+	return &ArrayStack[T]{deque.NewArrayDeque[T]()}
+}
+
+func (s *ArrayStack[T]) Push(value T) {
+	s.AddHead(value)
+}
+
+func (s *ArrayStack[T]) PushValues(values []T) {
+	for _, value := range values {
+		s.Push(value)
+	}
+}
+
+func (s *ArrayStack[T]) Pop() (T, error) {
+	return s.RemoveHead()
+}
+
+func (s *ArrayStack[T]) Peek() (T, error) {
+	return s.GetHead()
+}
+
+// MustPop is like Pop but panics on error.
+func (s *ArrayStack[T]) MustPop() T {
+	return collection.Must(s.Pop())
+}
+
+// MustPeek is like Peek but panics on error.
+func (s *ArrayStack[T]) MustPeek() T {
+	return collection.Must(s.Peek())
+}
+
+func (s *ArrayStack[T]) Clone() *ArrayStack[T] {
+	return &ArrayStack[T]{s.ArrayDeque.Clone()}
+}
+
+func (s *ArrayStack[T]) DescendingIterator() *DescendingIterator[T] {
+	return &DescendingIterator[T]{s.ArrayDeque.ReverseIterator()}
+}
@@ -1,6 +1,11 @@
 package stack
 
-import "sync"
+import (
+    "go-utils/collection"
+    "sync"
+)
+
+var _ collection.Stack[int] = (*ConcurrentStack[int])(nil)
 
 type ConcurrentStack[T comparable] struct {
     mu    sync.RWMutex
@@ -12,10 +17,16 @@ func NewConcurrentStack[T comparable]() *ConcurrentStack[T] {
 }
 
 func (s *ConcurrentStack[T]) Size() int {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
     return s.stack.Size()
 }
 
 func (s *ConcurrentStack[T]) IsEmpty() bool {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
     return s.stack.IsEmpty()
 }
 
@@ -33,6 +44,13 @@ func (s *ConcurrentStack[T]) Values() []T {
     return s.stack.Values()
 }
 
+func (s *ConcurrentStack[T]) Contains(value T) bool {
+    s.mu.RLock()
+    defer s.mu.RUnlock()
+
+    return s.stack.Contains(value)
+}
+
 func (s *ConcurrentStack[T]) Push(value T) {
     s.mu.Lock()
     defer s.mu.Unlock()
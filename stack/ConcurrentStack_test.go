@@ -30,18 +30,27 @@ func TestConcurrentStack(t *testing.T) {
     }
 
     // consume 100 items
+    var consumedMutex sync.Mutex
     consumed := make([]int, 0, 100)
     for c := 0; c < 10; c++ {
         wg.Add(1)
         go func() {
             defer wg.Done()
 
-            for len(consumed) < 100 {
+            for {
                 time.Sleep(time.Duration(100+rand.Intn(100)) * time.Millisecond)
+
+                consumedMutex.Lock()
+                if len(consumed) >= 100 {
+                    consumedMutex.Unlock()
+                    return
+                }
                 if !stack.IsEmpty() {
-                    value, _ := stack.Pop()
-                    consumed = append(consumed, value)
+                    if value, err := stack.Pop(); err == nil {
+                        consumed = append(consumed, value)
+                    }
                 }
+                consumedMutex.Unlock()
             }
         }()
     }
@@ -94,3 +103,32 @@ func TestConcurrentStack_Order(t *testing.T) {
         require.Equal(t, 99-i, consumed[i], "ConcurrentStack consumed item is not matched")
     }
 }
+
+func TestConcurrentStack_Stress(t *testing.T) {
+    stack := NewConcurrentStack[int]()
+
+    var wg sync.WaitGroup
+    var popped atomic.Int32
+    for w := 0; w < 16; w++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := 0; i < 500; i++ {
+                stack.Push(w*500 + i)
+                _ = stack.Size()
+                _ = stack.IsEmpty()
+                _ = stack.Contains(i)
+                _ = stack.Values()
+                _, _ = stack.Peek()
+                if i%2 == 0 {
+                    if _, err := stack.Pop(); err == nil {
+                        popped.Add(1)
+                    }
+                }
+            }
+        }()
+    }
+    wg.Wait()
+
+    require.Equal(t, 16*500, stack.Size()+int(popped.Load()), "ConcurrentStack size is not equal")
+}
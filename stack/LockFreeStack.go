@@ -0,0 +1,103 @@
+package stack
+
+import (
+	"go-utils/collection"
+	"sync/atomic"
+)
+
+var _ collection.Stack[int] = (*LockFreeStack[int])(nil)
+
+type lockFreeNode[T comparable] struct {
+	value T
+	next  *lockFreeNode[T]
+}
+
+// LockFreeStack is a Treiber stack: Push and Pop use compare-and-swap on the
+// top pointer instead of a mutex. Nodes are never modified once pushed.
+//
+// Size is weakly consistent while other goroutines are modifying the stack.
+type LockFreeStack[T comparable] struct {
+	top  atomic.Pointer[lockFreeNode[T]]
+	size atomic.Int64
+}
+
+func NewLockFreeStack[T comparable]() *LockFreeStack[T] {
+	return &LockFreeStack[T]{}
+}
+
+func (s *LockFreeStack[T]) Size() int {
+	return int(max(s.size.Load(), 0))
+}
+
+func (s *LockFreeStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// Values returns the values from top to bottom as of a single point in time.
+func (s *LockFreeStack[T]) Values() []T {
+	values := []T{}
+	for node := s.top.Load(); node != nil; node = node.next {
+		values = append(values, node.value)
+	}
+	return values
+}
+
+func (s *LockFreeStack[T]) Contains(value T) bool {
+	for node := s.top.Load(); node != nil; node = node.next {
+		if node.value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear pops until the stack is empty.
+func (s *LockFreeStack[T]) Clear() {
+	for {
+		if _, err := s.Pop(); err != nil {
+			return
+		}
+	}
+}
+
+func (s *LockFreeStack[T]) Push(value T) {
+	node := &lockFreeNode[T]{value: value}
+	for {
+		top := s.top.Load()
+		node.next = top
+		if s.top.CompareAndSwap(top, node) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+func (s *LockFreeStack[T]) PushValues(values []T) {
+	for _, value := range values {
+		s.Push(value)
+	}
+}
+
+func (s *LockFreeStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, collection.ErrEmpty
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+func (s *LockFreeStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	return top.value, nil
+}
@@ -0,0 +1,100 @@
+package stack
+
+import (
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFreeStack(t *testing.T) {
+	stack := NewLockFreeStack[int]()
+	require.True(t, stack.IsEmpty(), "LockFreeStack is not empty")
+	_, err := stack.Pop()
+	require.NotNil(t, err, "LockFreeStack pop on empty is not failed")
+	_, err = stack.Peek()
+	require.NotNil(t, err, "LockFreeStack peek on empty is not failed")
+
+	stack.PushValues([]int{1, 2, 3})
+	stack.Push(4)
+	require.Equal(t, 4, stack.Size(), "LockFreeStack size is not equal")
+	require.Equal(t, []int{4, 3, 2, 1}, stack.Values(), "LockFreeStack values are not equal")
+	require.True(t, stack.Contains(2), "LockFreeStack contains(2) is not matched")
+
+	for i := 4; i >= 1; i-- {
+		value, err := stack.Peek()
+		require.Nil(t, err, "LockFreeStack peek is failed")
+		require.Equal(t, i, value, "LockFreeStack peek item is not matched")
+		value, err = stack.Pop()
+		require.Nil(t, err, "LockFreeStack pop is failed")
+		require.Equal(t, i, value, "LockFreeStack pop item is not matched")
+	}
+	require.True(t, stack.IsEmpty(), "LockFreeStack is not empty")
+
+	stack.PushValues([]int{5, 6})
+	stack.Clear()
+	require.True(t, stack.IsEmpty(), "LockFreeStack is not empty")
+	require.Equal(t, 0, stack.Size(), "LockFreeStack size is not equal")
+}
+
+func TestLockFreeStack_Stress(t *testing.T) {
+	const workers, perWorker = 16, 2000
+	stack := NewLockFreeStack[int]()
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	popped := make([]int, 0, workers*perWorker)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			local := []int{}
+			for i := 0; i < perWorker; i++ {
+				stack.Push(w*perWorker + i)
+				if i%2 == 1 {
+					value, err := stack.Pop()
+					assert.Nil(t, err, "LockFreeStack pop is failed")
+					local = append(local, value)
+				}
+			}
+			mutex.Lock()
+			popped = append(popped, local...)
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	require.Equal(t, workers*perWorker/2, stack.Size(), "LockFreeStack size is not equal")
+	for !stack.IsEmpty() {
+		value, _ := stack.Pop()
+		popped = append(popped, value)
+	}
+
+	sort.Ints(popped)
+	require.Equal(t, workers*perWorker, len(popped), "LockFreeStack popped size is not equal")
+	for i, value := range popped {
+		require.Equal(t, i, value, "LockFreeStack popped item is not matched")
+	}
+}
+
+func BenchmarkLockFreeStack(b *testing.B) {
+	stack := NewLockFreeStack[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			stack.Push(i)
+			_, _ = stack.Pop()
+		}
+	})
+}
+
+func BenchmarkConcurrentStack(b *testing.B) {
+	stack := NewConcurrentStack[int]()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			stack.Push(i)
+			_, _ = stack.Pop()
+		}
+	})
+}
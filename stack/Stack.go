@@ -1,6 +1,15 @@
 package stack
 
-import "go-utils/list"
+import (
+    "go-utils/collection"
+    "go-utils/list"
+    "iter"
+)
+
+var (
+    _ collection.Stack[int] = (*Stack[int])(nil)
+    _ collection.Deque[int] = (*Stack[int])(nil)
+)
 
 type Stack[T comparable] struct {
     *list.LinkedList[T]
@@ -10,6 +19,15 @@ func NewStack[T comparable]() *Stack[T] {
     return &Stack[T]{list.NewLinkedList[T]()}
 }
 
+// Collect pushes the values of seq in order, so the last value ends up on top.
+func Collect[T comparable](seq iter.Seq[T]) *Stack[T] {
+    result := NewStack[T]()
+    for value := range seq {
+        result.Push(value)
+    }
+    return result
+}
+
 func (s *Stack[T]) Push(value T) {
     s.AddHead(value)
 }
@@ -27,3 +45,13 @@ func (s *Stack[T]) Pop() (T, error) {
 func (s *Stack[T]) Peek() (T, error) {
     return s.GetHead()
 }
+
+// MustPop is like Pop but panics on error.
+func (s *Stack[T]) MustPop() T {
+    return collection.Must(s.Pop())
+}
+
+// MustPeek is like Peek but panics on error.
+func (s *Stack[T]) MustPeek() T {
+    return collection.Must(s.Peek())
+}
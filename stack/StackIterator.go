@@ -0,0 +1,38 @@
+package stack
+
+import "go-utils/collection"
+
+// DescendingIterator walks a Stack or an ArrayStack from the bottom to the
+// top, oldest value first. It fails fast: once the stack changes size other
+// than through Remove, HasNext returns false and Err reports
+// collection.ErrConcurrentModification. Each step is O(1).
+type DescendingIterator[T comparable] struct {
+	// it is the ReverseIterator of the embedded list.LinkedList or
+	// deque.ArrayDeque.
+	it collection.Iterator[T]
+}
+
+func (s *Stack[T]) DescendingIterator() *DescendingIterator[T] {
+	return &DescendingIterator[T]{s.LinkedList.ReverseIterator()}
+}
+
+func (it *DescendingIterator[T]) HasNext() bool {
+	return it.it.HasNext()
+}
+
+// Next returns the next value, or the zero value if there is none or the
+// stack was modified.
+func (it *DescendingIterator[T]) Next() T {
+	return it.it.Next()
+}
+
+// Remove removes the value last returned by Next.
+func (it *DescendingIterator[T]) Remove() error {
+	return it.it.Remove()
+}
+
+// Err returns collection.ErrConcurrentModification once the iterator has
+// detected a modification of the stack.
+func (it *DescendingIterator[T]) Err() error {
+	return it.it.Err()
+}
@@ -1,6 +1,9 @@
 package stack
 
 import (
+    "go-utils/collection"
+    "go-utils/list"
+    "slices"
     "testing"
 
     "github.com/stretchr/testify/require"
@@ -36,14 +39,79 @@ func TestStack(t *testing.T) {
     validateStackPop(t, stack, 10)
 }
 
-func validateStackPop(t *testing.T, stack *Stack[int], expectedValue int) {
+func validateStackPop(t *testing.T, stack collection.Stack[int], expectedValue int) {
     value, err := stack.Pop()
     require.Nil(t, err, "Stack pop is failed")
     require.Equal(t, expectedValue, value, "Stack pop item is not matched")
 }
 
-func validateStackPeek(t *testing.T, stack *Stack[int], expectedValue int) {
+func validateStackPeek(t *testing.T, stack collection.Stack[int], expectedValue int) {
     value, err := stack.Peek()
     require.Nil(t, err, "Stack peek is failed")
     require.Equal(t, expectedValue, value, "Stack peek item is not matched")
 }
+
+func TestStack_All(t *testing.T) {
+    stack := Collect(slices.Values([]int{1, 2, 3, 4}))
+    require.Equal(t, []int{4, 3, 2, 1}, slices.Collect(stack.All()), "Stack all is failed")
+    require.Equal(t, []int{1, 2, 3, 4}, slices.Collect(stack.Backward()), "Stack backward is failed")
+    validateStackPeek(t, stack, 4)
+}
+
+func TestStack_Merge(t *testing.T) {
+    stack := NewStack[int]()
+    stack.Push(1)
+    other := list.NewLinkedList[int]()
+    other.AddAll([]int{2, 3})
+    stack.Merge(other)
+    require.Equal(t, []int{1, 2, 3}, stack.LinkedList.Values(), "Stack merge values are not equal")
+
+    clone := stack.Clone()
+    clone.RemoveHead()
+    require.Equal(t, []int{1, 2, 3}, stack.Values(), "Stack clone is not a copy")
+    validateStackPop(t, stack, 1)
+}
+
+func TestArrayStack(t *testing.T) {
+    stack := NewArrayStack[int]()
+    stack.PushValues([]int{1, 2, 3})
+    validateStackPeek(t, stack, 3)
+
+    clone := stack.Clone()
+    validateStackPop(t, clone, 3)
+    validateStackPop(t, stack, 3)
+    validateStackPop(t, stack, 2)
+    require.Equal(t, []int{2, 1}, clone.Values(), "ArrayStack clone is not a copy")
+    require.Equal(t, []int{1}, stack.Values(), "ArrayStack values are not equal")
+}
+
+// iterableStack is implemented by both Stack and ArrayStack.
+type iterableStack interface {
+    collection.Stack[int]
+    DescendingIterator() *DescendingIterator[int]
+}
+
+func TestStack_DescendingIterator(t *testing.T) {
+    for name, stack := range map[string]iterableStack{"LinkedList": NewStack[int](), "ArrayDeque": NewArrayStack[int]()} {
+        stack.PushValues([]int{1, 2, 3, 4})
+
+        it := stack.DescendingIterator()
+        values := []int{}
+        for it.HasNext() {
+            value := it.Next()
+            values = append(values, value)
+            if value == 2 {
+                require.Nil(t, it.Remove(), name+" stack descending iterator remove is failed")
+            }
+        }
+        require.Nil(t, it.Err(), name+" stack descending iterator error is not nil")
+        require.Equal(t, []int{1, 2, 3, 4}, values, name+" stack descending iterator values are not matched")
+        require.Equal(t, []int{4, 3, 1}, stack.Values(), name+" stack values are not equal")
+
+        it = stack.DescendingIterator()
+        require.ErrorIs(t, it.Remove(), collection.ErrIllegalState, name+" stack descending iterator remove before next is not failed")
+        stack.Pop()
+        require.False(t, it.HasNext(), name+" stack descending iterator has next after pop is not matched")
+        require.ErrorIs(t, it.Err(), collection.ErrConcurrentModification, name+" stack descending iterator error is not matched")
+    }
+}
@@ -0,0 +1,259 @@
+package stream
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Snapshotter is implemented by array.ConcurrentArray and list.ConcurrentList.
+// Parallel operations work on the copy returned by Snapshot, so writers are
+// only blocked while the copy is taken.
+type Snapshotter[T any] interface {
+	Snapshot() []T
+}
+
+type parallelConfig struct {
+	workers   int
+	batchSize int
+	ordered   bool
+}
+
+type ParallelOption func(*parallelConfig)
+
+// WithWorkers sets the number of worker goroutines; the default is GOMAXPROCS.
+func WithWorkers(workers int) ParallelOption {
+	return func(config *parallelConfig) {
+		config.workers = workers
+	}
+}
+
+// WithBatchSize sets how many values a worker takes at a time.
+func WithBatchSize(size int) ParallelOption {
+	return func(config *parallelConfig) {
+		config.batchSize = size
+	}
+}
+
+// Unordered lets results be assembled in the order batches finish instead of
+// the order of the source.
+func Unordered() ParallelOption {
+	return func(config *parallelConfig) {
+		config.ordered = false
+	}
+}
+
+func newParallelConfig(size int, options []ParallelOption) parallelConfig {
+	config := parallelConfig{workers: runtime.GOMAXPROCS(0), ordered: true}
+	for _, option := range options {
+		option(&config)
+	}
+
+	if config.workers <= 0 {
+		config.workers = 1
+	}
+	if config.batchSize <= 0 {
+		config.batchSize = max(1, size/(config.workers*4))
+	}
+	return config
+}
+
+func ParallelMap[T, V any](ctx context.Context, source Snapshotter[T], mapper func(T) (V, error), options ...ParallelOption) ([]V, error) {
+	return parallelBatches(ctx, source.Snapshot(), options, func(ctx context.Context, batch []T) ([]V, error) {
+		result := make([]V, 0, len(batch))
+		for _, value := range batch {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			mapped, err := mapper(value)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, mapped)
+		}
+		return result, nil
+	})
+}
+
+func ParallelFilter[T any](ctx context.Context, source Snapshotter[T], predicate func(T) (bool, error), options ...ParallelOption) ([]T, error) {
+	return parallelBatches(ctx, source.Snapshot(), options, func(ctx context.Context, batch []T) ([]T, error) {
+		result := make([]T, 0, len(batch))
+		for _, value := range batch {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			matched, err := predicate(value)
+			if err != nil {
+				return nil, err
+			}
+			if matched {
+				result = append(result, value)
+			}
+		}
+		return result, nil
+	})
+}
+
+func ParallelForEach[T any](ctx context.Context, source Snapshotter[T], action func(T) error, options ...ParallelOption) error {
+	_, err := parallelBatches(ctx, source.Snapshot(), options, func(ctx context.Context, batch []T) ([]struct{}, error) {
+		for _, value := range batch {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			if err := action(value); err != nil {
+				return nil, err
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// ParallelReduce folds each batch with accumulator starting from identity and
+// then folds the partial results with combiner. combiner must be associative
+// and identity must be its neutral element; with Unordered it must also be
+// commutative.
+func ParallelReduce[T, V any](ctx context.Context, source Snapshotter[T], identity V, accumulator func(V, T) (V, error), combiner func(V, V) V, options ...ParallelOption) (V, error) {
+	partials, err := parallelBatches(ctx, source.Snapshot(), options, func(ctx context.Context, batch []T) ([]V, error) {
+		acc := identity
+		for _, value := range batch {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+
+			var err error
+			if acc, err = accumulator(acc, value); err != nil {
+				return nil, err
+			}
+		}
+		return []V{acc}, nil
+	})
+	if err != nil {
+		return identity, err
+	}
+
+	result := identity
+	for _, partial := range partials {
+		result = combiner(result, partial)
+	}
+	return result, nil
+}
+
+// ParallelSort returns a stably sorted copy of the source. Batches are sorted
+// concurrently and then merged pairwise.
+func ParallelSort[T any](ctx context.Context, source Snapshotter[T], comparator func(a, b T) int, options ...ParallelOption) ([]T, error) {
+	options = append(slices.Clone(options), func(config *parallelConfig) { config.ordered = true })
+	runs, err := parallelBatches(ctx, source.Snapshot(), options, func(ctx context.Context, batch []T) ([][]T, error) {
+		sorted := slices.Clone(batch)
+		slices.SortStableFunc(sorted, comparator)
+		return [][]T{sorted}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for len(runs) > 1 {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		merged := make([][]T, (len(runs)+1)/2)
+		var wg sync.WaitGroup
+		for i := range merged {
+			if 2*i+1 == len(runs) {
+				merged[i] = runs[2*i]
+				continue
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				merged[i] = mergeRuns(runs[2*i], runs[2*i+1], comparator)
+			}()
+		}
+		wg.Wait()
+		runs = merged
+	}
+
+	if len(runs) == 0 {
+		return []T{}, nil
+	}
+	return runs[0], nil
+}
+
+// parallelBatches splits values into batches, runs process on them from a pool
+// of workers and concatenates the results. The first error cancels the
+// remaining work and is returned.
+func parallelBatches[T, V any](ctx context.Context, values []T, options []ParallelOption, process func(context.Context, []T) ([]V, error)) ([]V, error) {
+	config := newParallelConfig(len(values), options)
+	batches := (len(values) + config.batchSize - 1) / config.batchSize
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	var mu sync.Mutex
+	results := make([][]V, batches)
+	order := make([]int, 0, batches)
+
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for w := 0; w < min(config.workers, batches); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				batch := int(next.Add(1) - 1)
+				if batch >= batches || ctx.Err() != nil {
+					return
+				}
+
+				start := batch * config.batchSize
+				end := min(start+config.batchSize, len(values))
+				result, err := process(ctx, values[start:end])
+				if err != nil {
+					cancel(err)
+					return
+				}
+
+				mu.Lock()
+				results[batch] = result
+				order = append(order, batch)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(order) < batches {
+		return nil, context.Cause(ctx)
+	}
+
+	if config.ordered {
+		slices.Sort(order)
+	}
+	merged := []V{}
+	for _, batch := range order {
+		merged = append(merged, results[batch]...)
+	}
+	return merged, nil
+}
+
+func mergeRuns[T any](left, right []T, comparator func(a, b T) int) []T {
+	result := make([]T, 0, len(left)+len(right))
+	i, j := 0, 0
+	for i < len(left) && j < len(right) {
+		if comparator(left[i], right[j]) <= 0 {
+			result = append(result, left[i])
+			i++
+		} else {
+			result = append(result, right[j])
+			j++
+		}
+	}
+	result = append(result, left[i:]...)
+	return append(result, right[j:]...)
+}
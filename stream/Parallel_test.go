@@ -0,0 +1,121 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"go-utils/array"
+	"go-utils/list"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createParallelSource(size int) *array.ConcurrentArray[int] {
+	arr := array.NewConcurrentArray[int]()
+	for i := 0; i < size; i++ {
+		arr.Add((i * 7919) % size)
+	}
+	return arr
+}
+
+func TestParallelMap(t *testing.T) {
+	source := createParallelSource(100000)
+	double := func(value int) (int, error) { return value * 2, nil }
+
+	mapped, err := ParallelMap(context.Background(), source, double, WithWorkers(8))
+	require.Nil(t, err, "ParallelMap is failed")
+	expected := Map(Of(source.Snapshot()...), func(value int) int { return value * 2 }).ToSlice()
+	require.Equal(t, expected, mapped, "ParallelMap values are not equal")
+
+	unordered, err := ParallelMap(context.Background(), source, double, WithWorkers(8), WithBatchSize(100), Unordered())
+	require.Nil(t, err, "ParallelMap is failed")
+	slices.Sort(expected)
+	slices.Sort(unordered)
+	require.Equal(t, expected, unordered, "ParallelMap values are not matched")
+
+	empty, err := ParallelMap(context.Background(), array.NewConcurrentArray[int](), double)
+	require.Nil(t, err, "ParallelMap is failed")
+	require.Empty(t, empty, "ParallelMap values are not empty")
+}
+
+func TestParallelFilter(t *testing.T) {
+	source := list.NewConcurrentList[int]()
+	for i := 0; i < 10000; i++ {
+		source.Add(i)
+	}
+
+	even := func(value int) (bool, error) { return value%2 == 0, nil }
+	filtered, err := ParallelFilter(context.Background(), source, even, WithWorkers(4), WithBatchSize(64))
+	require.Nil(t, err, "ParallelFilter is failed")
+	require.Equal(t, 5000, len(filtered), "ParallelFilter size is not equal")
+	require.True(t, slices.IsSorted(filtered), "ParallelFilter did not preserve order")
+}
+
+func TestParallelReduce(t *testing.T) {
+	source := createParallelSource(100000)
+	sum, err := ParallelReduce(context.Background(), source, 0,
+		func(acc, value int) (int, error) { return acc + value, nil },
+		func(a, b int) int { return a + b },
+		WithWorkers(8), Unordered())
+	require.Nil(t, err, "ParallelReduce is failed")
+	require.Equal(t, 100000*99999/2, sum, "ParallelReduce result is not equal")
+}
+
+func TestParallelForEach(t *testing.T) {
+	source := createParallelSource(10000)
+	var total atomic.Int64
+	err := ParallelForEach(context.Background(), source, func(value int) error {
+		total.Add(int64(value))
+		return nil
+	}, WithWorkers(8))
+	require.Nil(t, err, "ParallelForEach is failed")
+	require.Equal(t, int64(10000*9999/2), total.Load(), "ParallelForEach total is not equal")
+}
+
+func TestParallelSort(t *testing.T) {
+	source := createParallelSource(100001)
+	cmp := func(a, b int) int { return a - b }
+
+	sorted, err := ParallelSort(context.Background(), source, cmp, WithWorkers(8), Unordered())
+	require.Nil(t, err, "ParallelSort is failed")
+	require.Equal(t, 100001, len(sorted), "ParallelSort size is not equal")
+	require.True(t, slices.IsSorted(sorted), "ParallelSort values are not sorted")
+	require.False(t, slices.IsSorted(source.Snapshot()), "ParallelSort modified the source")
+}
+
+func TestParallel_Error(t *testing.T) {
+	source := createParallelSource(100000)
+	failure := errors.New("mapper failed")
+	failing := source.Snapshot()[50]
+
+	var calls atomic.Int64
+	_, err := ParallelMap(context.Background(), source, func(value int) (int, error) {
+		calls.Add(1)
+		if value == failing {
+			return 0, failure
+		}
+		return value, nil
+	}, WithWorkers(4), WithBatchSize(10))
+	require.ErrorIs(t, err, failure, "ParallelMap error is not propagated")
+	require.Less(t, calls.Load(), int64(100000), "ParallelMap did not stop on error")
+}
+
+func TestParallel_Cancel(t *testing.T) {
+	source := createParallelSource(100000)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int64
+	err := ParallelForEach(ctx, source, func(value int) error {
+		if calls.Add(1) == 100 {
+			cancel()
+		}
+		return nil
+	}, WithWorkers(4))
+	require.ErrorIs(t, err, context.Canceled, "ParallelForEach is not cancelled")
+	require.Less(t, calls.Load(), int64(100000), "ParallelForEach did not stop on cancel")
+
+	_, err = ParallelSort(ctx, source, func(a, b int) int { return a - b })
+	require.ErrorIs(t, err, context.Canceled, "ParallelSort is not cancelled")
+}
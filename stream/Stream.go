@@ -0,0 +1,361 @@
+package stream
+
+import (
+	"go-utils/collection"
+	"iter"
+	"slices"
+)
+
+// Iterator is implemented by the Java-style iterators of array, list and queue.
+type Iterator[T any] interface {
+	HasNext() bool
+	Next() T
+}
+
+// Stream is a lazy pipeline over a sequence of values. Intermediate operations
+// only describe the pipeline; nothing is evaluated until a terminal operation
+// pulls values through it.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+func Of[T any](values ...T) *Stream[T] {
+	return FromSeq(slices.Values(values))
+}
+
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{seq: seq}
+}
+
+// FromIterator wraps an existing iterator. The iterator is consumed by the
+// first terminal operation, so the resulting stream can only be used once.
+func FromIterator[T any](it Iterator[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for it.HasNext() {
+			if !yield(it.Next()) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) All() iter.Seq[T] {
+	return s.seq
+}
+
+// Intermediate operations
+
+func (s *Stream[T]) Filter(predicate func(T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		count := 0
+		for value := range s.seq {
+			if !yield(value) {
+				return
+			}
+			count++
+			if count >= n {
+				return
+			}
+		}
+	})
+}
+
+// Limit is an alias of Take.
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	return s.Take(n)
+}
+
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		count := 0
+		for value := range s.seq {
+			if count < n {
+				count++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) TakeWhile(predicate func(T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			if !predicate(value) || !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) DropWhile(predicate func(T) bool) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		dropping := true
+		for value := range s.seq {
+			if dropping && predicate(value) {
+				continue
+			}
+			dropping = false
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Sorted buffers the whole upstream before yielding the first value.
+func (s *Stream[T]) Sorted(comparator func(a, b T) int) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		values := slices.Collect(s.seq)
+		slices.SortStableFunc(values, comparator)
+		for _, value := range values {
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) Peek(action func(T)) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for value := range s.seq {
+			action(value)
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+func (s *Stream[T]) Concat(others ...*Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		for _, stream := range append([]*Stream[T]{s}, others...) {
+			for value := range stream.seq {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	})
+}
+
+// Chunk groups consecutive values into slices of the given size; the last chunk
+// may be shorter.
+func Chunk[T any](s *Stream[T], size int) *Stream[[]T] {
+	return FromSeq(func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+
+		chunk := make([]T, 0, size)
+		for value := range s.seq {
+			chunk = append(chunk, value)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	})
+}
+
+// Window yields every run of size consecutive values, sliding by one.
+func Window[T any](s *Stream[T], size int) *Stream[[]T] {
+	return FromSeq(func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+
+		window := make([]T, 0, size)
+		for value := range s.seq {
+			if len(window) == size {
+				window = window[1:]
+			}
+			window = append(window, value)
+			if len(window) == size && !yield(slices.Clone(window)) {
+				return
+			}
+		}
+	})
+}
+
+func Map[T, V any](s *Stream[T], mapper func(T) V) *Stream[V] {
+	return FromSeq(func(yield func(V) bool) {
+		for value := range s.seq {
+			if !yield(mapper(value)) {
+				return
+			}
+		}
+	})
+}
+
+func FlatMap[T, V any](s *Stream[T], mapper func(T) iter.Seq[V]) *Stream[V] {
+	return FromSeq(func(yield func(V) bool) {
+		for value := range s.seq {
+			for mapped := range mapper(value) {
+				if !yield(mapped) {
+					return
+				}
+			}
+		}
+	})
+}
+
+func Distinct[T comparable](s *Stream[T]) *Stream[T] {
+	return FromSeq(func(yield func(T) bool) {
+		seen := map[T]struct{}{}
+		for value := range s.seq {
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	})
+}
+
+// Zip pairs up the values of both streams and stops at the end of the shorter one.
+func Zip[T, U, V any](left *Stream[T], right *Stream[U], zipper func(T, U) V) *Stream[V] {
+	return FromSeq(func(yield func(V) bool) {
+		next, stop := iter.Pull(right.seq)
+		defer stop()
+
+		for value := range left.seq {
+			other, ok := next()
+			if !ok || !yield(zipper(value, other)) {
+				return
+			}
+		}
+	})
+}
+
+// Terminal operations
+
+func (s *Stream[T]) ForEach(action func(T)) {
+	for value := range s.seq {
+		action(value)
+	}
+}
+
+func (s *Stream[T]) ToSlice() []T {
+	return slices.Collect(s.seq)
+}
+
+func (s *Stream[T]) Count() int {
+	count := 0
+	for range s.seq {
+		count++
+	}
+	return count
+}
+
+func (s *Stream[T]) AnyMatch(predicate func(T) bool) bool {
+	for value := range s.seq {
+		if predicate(value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Stream[T]) AllMatch(predicate func(T) bool) bool {
+	for value := range s.seq {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Stream[T]) NoneMatch(predicate func(T) bool) bool {
+	return !s.AnyMatch(predicate)
+}
+
+func (s *Stream[T]) FindFirst() (T, error) {
+	for value := range s.seq {
+		return value, nil
+	}
+
+	var zero T
+	return zero, collection.ErrEmpty
+}
+
+func (s *Stream[T]) Min(comparator func(a, b T) int) (T, error) {
+	return s.best(func(a, b T) bool { return comparator(a, b) < 0 })
+}
+
+func (s *Stream[T]) Max(comparator func(a, b T) int) (T, error) {
+	return s.best(func(a, b T) bool { return comparator(a, b) > 0 })
+}
+
+// PartitionBy splits the values into those matching the predicate (true) and
+// the rest (false).
+func (s *Stream[T]) PartitionBy(predicate func(T) bool) map[bool][]T {
+	result := map[bool][]T{true: {}, false: {}}
+	for value := range s.seq {
+		key := predicate(value)
+		result[key] = append(result[key], value)
+	}
+	return result
+}
+
+func (s *Stream[T]) best(better func(a, b T) bool) (T, error) {
+	var result T
+	found := false
+	for value := range s.seq {
+		if !found || better(value, result) {
+			result = value
+			found = true
+		}
+	}
+
+	if !found {
+		return result, collection.ErrEmpty
+	}
+	return result, nil
+}
+
+func Reduce[T, V any](s *Stream[T], initial V, reducer func(acc V, item T) V) V {
+	acc := initial
+	for value := range s.seq {
+		acc = reducer(acc, value)
+	}
+	return acc
+}
+
+// Collect drains the stream into a collection using one of the Collect
+// constructors, e.g. stream.Collect(s, array.Collect[int]).
+func Collect[T, C any](s *Stream[T], collector func(iter.Seq[T]) C) C {
+	return collector(s.seq)
+}
+
+func GroupBy[T any, K comparable](s *Stream[T], classifier func(T) K) map[K][]T {
+	result := map[K][]T{}
+	for value := range s.seq {
+		key := classifier(value)
+		result[key] = append(result[key], value)
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+package stream
+
+import (
+	"go-utils/array"
+	"go-utils/list"
+	"go-utils/queue"
+	"go-utils/tree"
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStream_Lazy(t *testing.T) {
+	visited := 0
+	s := Of(1, 2, 3, 4, 5, 6, 7, 8).
+		Peek(func(int) { visited++ }).
+		Filter(func(value int) bool { return value%2 == 0 })
+	require.Equal(t, 0, visited, "Stream is not lazy")
+
+	first, err := s.FindFirst()
+	require.Nil(t, err, "Stream find first is failed")
+	require.Equal(t, 2, first, "Stream find first is not matched")
+	require.Equal(t, 2, visited, "Stream visited count is not matched")
+}
+
+func TestStream_FilterMapReduce(t *testing.T) {
+	arr := array.NewArrayList[int]()
+	arr.AddAll([]int{1, 2, 3, 4, 5, 6})
+
+	evens := FromIterator[int](arr.Iterator()).Filter(func(value int) bool { return value%2 == 0 })
+	doubled := Map(evens, func(value int) int { return value * 2 })
+	sum := Reduce(doubled, 0, func(acc, value int) int { return acc + value })
+	require.Equal(t, 24, sum, "Stream reduce is failed")
+
+	strs := Map(FromSeq(arr.All()), strconv.Itoa).ToSlice()
+	require.Equal(t, []string{"1", "2", "3", "4", "5", "6"}, strs, "Stream map is failed")
+}
+
+func TestStream_FlatMap(t *testing.T) {
+	result := FlatMap(Of(1, 2, 3), func(value int) iter.Seq[int] {
+		return slices.Values(slices.Repeat([]int{value}, value))
+	}).ToSlice()
+	require.Equal(t, []int{1, 2, 2, 3, 3, 3}, result, "Stream flat map is failed")
+}
+
+func TestStream_Slicing(t *testing.T) {
+	require.Equal(t, []int{1, 2, 3}, Of(1, 2, 3, 4, 5).Take(3).ToSlice(), "Stream take is failed")
+	require.Equal(t, []int{1, 2}, Of(1, 2, 3, 4, 5).Limit(2).ToSlice(), "Stream limit is failed")
+	require.Empty(t, Of(1, 2, 3).Take(0).ToSlice(), "Stream take(0) is not empty")
+	require.Equal(t, []int{4, 5}, Of(1, 2, 3, 4, 5).Skip(3).ToSlice(), "Stream skip is failed")
+	require.Empty(t, Of(1, 2, 3).Skip(5).ToSlice(), "Stream skip(5) is not empty")
+
+	less := func(value int) bool { return value < 3 }
+	require.Equal(t, []int{1, 2}, Of(1, 2, 3, 1, 2).TakeWhile(less).ToSlice(), "Stream take while is failed")
+	require.Equal(t, []int{3, 1, 2}, Of(1, 2, 3, 1, 2).DropWhile(less).ToSlice(), "Stream drop while is failed")
+}
+
+func TestStream_DistinctSorted(t *testing.T) {
+	require.Equal(t, []int{3, 1, 2}, Distinct(Of(3, 1, 3, 2, 1)).ToSlice(), "Stream distinct is failed")
+
+	sorted := Of(3, 1, 3, 2, 1).Sorted(func(a, b int) int { return a - b }).ToSlice()
+	require.Equal(t, []int{1, 1, 2, 3, 3}, sorted, "Stream sorted is failed")
+}
+
+func TestStream_ChunkWindow(t *testing.T) {
+	chunks := Chunk(Of(1, 2, 3, 4, 5), 2).ToSlice()
+	require.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks, "Stream chunk is failed")
+
+	windows := Window(Of(1, 2, 3, 4), 3).ToSlice()
+	require.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}}, windows, "Stream window is failed")
+	require.Empty(t, Window(Of(1, 2), 3).ToSlice(), "Stream window is not empty")
+}
+
+func TestStream_ZipConcat(t *testing.T) {
+	zipped := Zip(Of(1, 2, 3), Of("a", "b"), func(n int, s string) string { return s + strconv.Itoa(n) }).ToSlice()
+	require.Equal(t, []string{"a1", "b2"}, zipped, "Stream zip is failed")
+
+	require.Equal(t, []int{1, 2, 3, 4}, Of(1, 2).Concat(Of(3), Of(4)).ToSlice(), "Stream concat is failed")
+	require.Equal(t, []int{1, 2, 3}, Of(1, 2).Concat(Of(3, 4)).Take(3).ToSlice(), "Stream concat take is failed")
+}
+
+func TestStream_Terminal(t *testing.T) {
+	even := func(value int) bool { return value%2 == 0 }
+	cmp := func(a, b int) int { return a - b }
+
+	require.Equal(t, 5, Of(5, 3, 8, 1, 9).Count(), "Stream count is failed")
+	require.True(t, Of(1, 3, 4).AnyMatch(even), "Stream any match is failed")
+	require.False(t, Of(1, 3, 4).AllMatch(even), "Stream all match is failed")
+	require.True(t, Of[int]().AllMatch(even), "Stream all match is failed")
+	require.True(t, Of(1, 3).NoneMatch(even), "Stream none match is failed")
+
+	min, err := Of(5, 3, 8, 1, 9).Min(cmp)
+	require.Nil(t, err, "Stream min is failed")
+	require.Equal(t, 1, min, "Stream min is not matched")
+	max, err := Of(5, 3, 8, 1, 9).Max(cmp)
+	require.Nil(t, err, "Stream max is failed")
+	require.Equal(t, 9, max, "Stream max is not matched")
+
+	_, err = Of[int]().Min(cmp)
+	require.NotNil(t, err, "Stream min is not failed")
+	_, err = Of[int]().FindFirst()
+	require.NotNil(t, err, "Stream find first is not failed")
+
+	collected := []int{}
+	Of(1, 2, 3).ForEach(func(value int) { collected = append(collected, value) })
+	require.Equal(t, []int{1, 2, 3}, collected, "Stream for each is failed")
+}
+
+func TestStream_Grouping(t *testing.T) {
+	groups := GroupBy(Of("apple", "avocado", "banana", "cherry", "blueberry"), func(s string) byte { return s[0] })
+	require.Equal(t, map[byte][]string{
+		'a': {"apple", "avocado"},
+		'b': {"banana", "blueberry"},
+		'c': {"cherry"},
+	}, groups, "Stream group by is failed")
+
+	parts := Of(1, 2, 3, 4, 5).PartitionBy(func(value int) bool { return value%2 == 0 })
+	require.Equal(t, []int{2, 4}, parts[true], "Stream partition by is failed")
+	require.Equal(t, []int{1, 3, 5}, parts[false], "Stream partition by is failed")
+}
+
+func TestStream_Collect(t *testing.T) {
+	cmp := func(a, b int) int { return a - b }
+
+	arr := Collect(Of(3, 1, 2), array.Collect[int])
+	require.Equal(t, []int{3, 1, 2}, arr.Values(), "Stream collect array is failed")
+
+	linked := Collect(Of(3, 1, 2), list.Collect[int])
+	require.Equal(t, []int{3, 1, 2}, linked.Values(), "Stream collect list is failed")
+
+	q := Collect(Of(3, 1, 2), queue.Collect[int])
+	require.Equal(t, []int{3, 1, 2}, q.Values(), "Stream collect queue is failed")
+
+	bt := Collect(FromIterator[int](linked.Iterator()), func(seq iter.Seq[int]) *tree.BinaryTree[int] {
+		return tree.Collect(seq, cmp)
+	})
+	require.Equal(t, []int{1, 2, 3}, bt.Values(), "Stream collect tree is failed")
+}
@@ -1,16 +1,23 @@
 package tree
 
 import (
-    "errors"
+    "go-utils/collection"
     "go-utils/queue"
 )
 
+var _ collection.SortedCollection[int] = (*BinaryTree[int])(nil)
+
 type treeNode[T comparable] struct {
     value T
     left  *treeNode[T]
     right *treeNode[T]
+    // size is the number of nodes in the subtree rooted at this node.
+    size int
 }
 
+// BinaryTree is an unbalanced binary search tree that keeps duplicate values.
+// Sorted input degrades it to a linked list; use TreeSet or TreeMap instead
+// when the input may be ordered.
 type BinaryTree[T comparable] struct {
     head       *treeNode[T]
     size       int
@@ -79,7 +86,7 @@ func (s *BinaryTree[T]) Clear() {
 func (s *BinaryTree[T]) Peek() (T, error) {
     if s.IsEmpty() {
         var zero T
-        return zero, errors.New("tree is empty")
+        return zero, collection.ErrEmpty
     }
 
     return findLeftMostNode(s.head).value, nil
@@ -88,7 +95,7 @@ func (s *BinaryTree[T]) Peek() (T, error) {
 func (s *BinaryTree[T]) Poll() (T, error) {
     if s.IsEmpty() {
         var zero T
-        return zero, errors.New("tree is empty")
+        return zero, collection.ErrEmpty
     }
 
     top, value := poll(s.head)
@@ -97,6 +104,16 @@ func (s *BinaryTree[T]) Poll() (T, error) {
     return value, nil
 }
 
+// MustPeek is like Peek but panics on error.
+func (s *BinaryTree[T]) MustPeek() T {
+    return collection.Must(s.Peek())
+}
+
+// MustPoll is like Poll but panics on error.
+func (s *BinaryTree[T]) MustPoll() T {
+    return collection.Must(s.Poll())
+}
+
 func (s *BinaryTree[T]) Clone() *BinaryTree[T] {
     if s.IsEmpty() {
         return NewBinaryTree[T](s.comparator)
@@ -106,7 +123,7 @@ func (s *BinaryTree[T]) Clone() *BinaryTree[T] {
     src.Offer(s.head)
 
     dest := queue.NewQueue[*treeNode[T]]()
-    destHead := &treeNode[T]{value: s.head.value}
+    destHead := &treeNode[T]{value: s.head.value, size: s.head.size}
     dest.Offer(destHead)
 
     for !src.IsEmpty() {
@@ -116,13 +133,13 @@ func (s *BinaryTree[T]) Clone() *BinaryTree[T] {
             target, _ := dest.Poll()
 
             if node.left != nil {
-                target.left = &treeNode[T]{value: node.left.value}
+                target.left = &treeNode[T]{value: node.left.value, size: node.left.size}
                 src.Offer(node.left)
                 dest.Offer(target.left)
             }
 
             if node.right != nil {
-                target.right = &treeNode[T]{value: node.right.value}
+                target.right = &treeNode[T]{value: node.right.value, size: node.right.size}
                 src.Offer(node.right)
                 dest.Offer(target.right)
             }
@@ -143,6 +160,7 @@ func poll[T comparable](node *treeNode[T]) (*treeNode[T], T) {
 
     left, value := poll(node.left)
     node.left = left
+    node.size--
     return node, value
 }
 
@@ -158,9 +176,10 @@ func collect[T comparable](node *treeNode[T], values *queue.Queue[T]) {
 
 func add[T comparable](node *treeNode[T], value T, comparator func(a, b T) int) *treeNode[T] {
     if node == nil {
-        return &treeNode[T]{value: value}
+        return &treeNode[T]{value: value, size: 1}
     }
 
+    node.size++
     if comparator(value, node.value) <= 0 {
         node.left = add(node.left, value, comparator)
     } else {
@@ -199,17 +218,20 @@ func remove[T comparable](node *treeNode[T], value T, comparator func(a, b T) in
         right, leftMost := detachLeftMostNode(node.right)
         leftMost.left = node.left
         leftMost.right = right
+        leftMost.size = node.size - 1
         return leftMost, true
     }
 
+    var removed bool
     if result < 0 {
-        left, removed := remove(node.left, value, comparator)
-        node.left = left
-        return node, removed
+        node.left, removed = remove(node.left, value, comparator)
+    } else {
+        node.right, removed = remove(node.right, value, comparator)
     }
 
-    right, removed := remove(node.right, value, comparator)
-    node.right = right
+    if removed {
+        node.size--
+    }
     return node, removed
 }
 
@@ -227,5 +249,13 @@ func detachLeftMostNode[T comparable](node *treeNode[T]) (*treeNode[T], *treeNod
 
     left, end := detachLeftMostNode(node.left)
     node.left = left
+    node.size--
     return node, end
 }
+
+func sizeOf[T comparable](node *treeNode[T]) int {
+    if node == nil {
+        return 0
+    }
+    return node.size
+}
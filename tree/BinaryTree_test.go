@@ -1,6 +1,7 @@
 package tree
 
 import (
+    "slices"
     "testing"
 
     "github.com/stretchr/testify/assert"
@@ -148,3 +149,31 @@ func validateTreePeek(t *testing.T, tree *BinaryTree[int], expectedValue int) {
     require.Nil(t, err, "Tree peek is failed")
     require.Equal(t, expectedValue, value, "Tree peek item is not matched")
 }
+
+func TestBinaryTree_All(t *testing.T) {
+    //       7
+    //     2   8
+    //    1 4    9
+    tree := Collect(slices.Values([]int{7, 2, 8, 1, 4, 9}), func(a, b int) int { return a - b })
+
+    assert.Equal(t, []int{1, 2, 4, 7, 8, 9}, slices.Collect(tree.All()), "BinaryTree all is failed")
+    assert.Equal(t, []int{9, 8, 7, 4, 2, 1}, slices.Collect(tree.Backward()), "BinaryTree backward is failed")
+    assert.Equal(t, []int{7, 2, 1, 4, 8, 9}, slices.Collect(tree.PreOrder()), "BinaryTree pre-order is failed")
+    assert.Equal(t, []int{1, 4, 2, 9, 8, 7}, slices.Collect(tree.PostOrder()), "BinaryTree post-order is failed")
+    assert.Equal(t, []int{7, 2, 8, 1, 4, 9}, slices.Collect(tree.LevelOrder()), "BinaryTree level-order is failed")
+
+    for i, value := range tree.Enumerate() {
+        assert.Equal(t, tree.Values()[i], value, "BinaryTree enumerate item is not matched")
+    }
+
+    // stop early
+    visited := []int{}
+    for value := range tree.PostOrder() {
+        if value == 8 {
+            break
+        }
+        visited = append(visited, value)
+    }
+    assert.Equal(t, []int{1, 4, 2, 9}, visited, "BinaryTree post-order break is failed")
+    assert.Empty(t, slices.Collect(NewBinaryTree[int](func(a, b int) int { return a - b }).LevelOrder()), "BinaryTree level-order is not empty")
+}
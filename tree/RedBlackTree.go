@@ -0,0 +1,351 @@
+package tree
+
+type rbNode[K comparable, V any] struct {
+	key    K
+	value  V
+	red    bool
+	left   *rbNode[K, V]
+	right  *rbNode[K, V]
+	parent *rbNode[K, V]
+}
+
+// rbTree is the red-black tree behind TreeMap and TreeSet. All operations are
+// iterative, so the height (at most 2*log2(n+1)) never grows the call stack.
+type rbTree[K comparable, V any] struct {
+	root       *rbNode[K, V]
+	size       int
+	modCount   int
+	comparator func(a, b K) int
+}
+
+func newRBTree[K comparable, V any](comparator func(a, b K) int) *rbTree[K, V] {
+	return &rbTree[K, V]{comparator: comparator}
+}
+
+func (t *rbTree[K, V]) clear() {
+	t.root = nil
+	t.size = 0
+	t.modCount++
+}
+
+func (t *rbTree[K, V]) find(key K) *rbNode[K, V] {
+	node := t.root
+	for node != nil {
+		result := t.comparator(key, node.key)
+		if result == 0 {
+			return node
+		} else if result < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return nil
+}
+
+// insert adds a node for the key, or returns the existing node and false.
+func (t *rbTree[K, V]) insert(key K, value V) (*rbNode[K, V], bool) {
+	var parent *rbNode[K, V]
+	result := 0
+	for node := t.root; node != nil; {
+		parent = node
+		result = t.comparator(key, node.key)
+		if result == 0 {
+			return node, false
+		} else if result < 0 {
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	node := &rbNode[K, V]{key: key, value: value, red: true, parent: parent}
+	if parent == nil {
+		t.root = node
+	} else if result < 0 {
+		parent.left = node
+	} else {
+		parent.right = node
+	}
+
+	t.insertFixup(node)
+	t.size++
+	t.modCount++
+	return node, true
+}
+
+func (t *rbTree[K, V]) insertFixup(node *rbNode[K, V]) {
+	for node.parent != nil && node.parent.red {
+		grandparent := node.parent.parent
+		if node.parent == grandparent.left {
+			uncle := grandparent.right
+			if isRed(uncle) {
+				node.parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				node = grandparent
+				continue
+			}
+
+			if node == node.parent.right {
+				node = node.parent
+				t.rotateLeft(node)
+			}
+			node.parent.red = false
+			grandparent.red = true
+			t.rotateRight(grandparent)
+		} else {
+			uncle := grandparent.left
+			if isRed(uncle) {
+				node.parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				node = grandparent
+				continue
+			}
+
+			if node == node.parent.left {
+				node = node.parent
+				t.rotateRight(node)
+			}
+			node.parent.red = false
+			grandparent.red = true
+			t.rotateLeft(grandparent)
+		}
+	}
+	t.root.red = false
+}
+
+// delete unlinks the node. Other nodes keep their identity, so pointers held
+// by iterators to nodes other than the deleted one stay valid.
+func (t *rbTree[K, V]) delete(node *rbNode[K, V]) {
+	removedRed := node.red
+	var child, parent *rbNode[K, V]
+
+	if node.left == nil {
+		child, parent = node.right, node.parent
+		t.transplant(node, node.right)
+	} else if node.right == nil {
+		child, parent = node.left, node.parent
+		t.transplant(node, node.left)
+	} else {
+		successor := minNode(node.right)
+		removedRed = successor.red
+		child = successor.right
+		if successor.parent == node {
+			parent = successor
+		} else {
+			parent = successor.parent
+			t.transplant(successor, successor.right)
+			successor.right = node.right
+			successor.right.parent = successor
+		}
+		t.transplant(node, successor)
+		successor.left = node.left
+		successor.left.parent = successor
+		successor.red = node.red
+	}
+
+	if !removedRed {
+		t.deleteFixup(child, parent)
+	}
+	node.left, node.right, node.parent = nil, nil, nil
+	t.size--
+	t.modCount++
+}
+
+func (t *rbTree[K, V]) deleteFixup(node, parent *rbNode[K, V]) {
+	for node != t.root && !isRed(node) {
+		if node == parent.left {
+			sibling := parent.right
+			if isRed(sibling) {
+				sibling.red = false
+				parent.red = true
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+
+			if !isRed(sibling.left) && !isRed(sibling.right) {
+				sibling.red = true
+				node, parent = parent, parent.parent
+				continue
+			}
+
+			if !isRed(sibling.right) {
+				sibling.left.red = false
+				sibling.red = true
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.right.red = false
+			t.rotateLeft(parent)
+			node = t.root
+		} else {
+			sibling := parent.left
+			if isRed(sibling) {
+				sibling.red = false
+				parent.red = true
+				t.rotateRight(parent)
+				sibling = parent.left
+			}
+
+			if !isRed(sibling.left) && !isRed(sibling.right) {
+				sibling.red = true
+				node, parent = parent, parent.parent
+				continue
+			}
+
+			if !isRed(sibling.left) {
+				sibling.right.red = false
+				sibling.red = true
+				t.rotateLeft(sibling)
+				sibling = parent.left
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.left.red = false
+			t.rotateRight(parent)
+			node = t.root
+		}
+	}
+
+	if node != nil {
+		node.red = false
+	}
+}
+
+func (t *rbTree[K, V]) transplant(target, replacement *rbNode[K, V]) {
+	if target.parent == nil {
+		t.root = replacement
+	} else if target == target.parent.left {
+		target.parent.left = replacement
+	} else {
+		target.parent.right = replacement
+	}
+
+	if replacement != nil {
+		replacement.parent = target.parent
+	}
+}
+
+func (t *rbTree[K, V]) rotateLeft(node *rbNode[K, V]) {
+	right := node.right
+	node.right = right.left
+	if right.left != nil {
+		right.left.parent = node
+	}
+	t.transplant(node, right)
+	right.left = node
+	node.parent = right
+}
+
+func (t *rbTree[K, V]) rotateRight(node *rbNode[K, V]) {
+	left := node.left
+	node.left = left.right
+	if left.right != nil {
+		left.right.parent = node
+	}
+	t.transplant(node, left)
+	left.right = node
+	node.parent = left
+}
+
+func (t *rbTree[K, V]) first() *rbNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return minNode(t.root)
+}
+
+func (t *rbTree[K, V]) last() *rbNode[K, V] {
+	if t.root == nil {
+		return nil
+	}
+	return maxNode(t.root)
+}
+
+// floor returns the node with the greatest key less than or equal to key, or
+// strictly less than key when inclusive is false.
+func (t *rbTree[K, V]) floor(key K, inclusive bool) *rbNode[K, V] {
+	var found *rbNode[K, V]
+	node := t.root
+	for node != nil {
+		result := t.comparator(key, node.key)
+		if result == 0 && inclusive {
+			return node
+		}
+
+		if result > 0 {
+			found = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	return found
+}
+
+// ceiling returns the node with the least key greater than or equal to key, or
+// strictly greater than key when inclusive is false.
+func (t *rbTree[K, V]) ceiling(key K, inclusive bool) *rbNode[K, V] {
+	var found *rbNode[K, V]
+	node := t.root
+	for node != nil {
+		result := t.comparator(key, node.key)
+		if result == 0 && inclusive {
+			return node
+		}
+
+		if result < 0 {
+			found = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	return found
+}
+
+func successor[K comparable, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	if node.right != nil {
+		return minNode(node.right)
+	}
+
+	parent := node.parent
+	for parent != nil && node == parent.right {
+		node, parent = parent, parent.parent
+	}
+	return parent
+}
+
+func predecessor[K comparable, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	if node.left != nil {
+		return maxNode(node.left)
+	}
+
+	parent := node.parent
+	for parent != nil && node == parent.left {
+		node, parent = parent, parent.parent
+	}
+	return parent
+}
+
+func minNode[K comparable, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func maxNode[K comparable, V any](node *rbNode[K, V]) *rbNode[K, V] {
+	for node.right != nil {
+		node = node.right
+	}
+	return node
+}
+
+func isRed[K comparable, V any](node *rbNode[K, V]) bool {
+	return node != nil && node.red
+}
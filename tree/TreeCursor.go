@@ -0,0 +1,137 @@
+package tree
+
+// Cursor is a position in a BinaryTree that can seek to a value and step
+// forward or backward in order. It keeps the path from the root to the
+// current node, so each step costs O(height) at worst and O(1) amortized.
+//
+// Modifying the tree invalidates the cursor; seek again before using it.
+type Cursor[T comparable] struct {
+	tree *BinaryTree[T]
+	path []*treeNode[T]
+}
+
+// Cursor returns an unpositioned cursor over the tree.
+func (s *BinaryTree[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{tree: s}
+}
+
+// Valid reports whether the cursor is positioned on a value.
+func (c *Cursor[T]) Valid() bool {
+	return len(c.path) > 0
+}
+
+// Value returns the value under the cursor, or the zero value if the cursor
+// is not valid.
+func (c *Cursor[T]) Value() T {
+	if !c.Valid() {
+		var zero T
+		return zero
+	}
+	return c.path[len(c.path)-1].value
+}
+
+// First moves the cursor to the smallest value.
+func (c *Cursor[T]) First() bool {
+	c.path = c.path[:0]
+	c.pushLeft(c.tree.head)
+	return c.Valid()
+}
+
+// Last moves the cursor to the largest value.
+func (c *Cursor[T]) Last() bool {
+	c.path = c.path[:0]
+	c.pushRight(c.tree.head)
+	return c.Valid()
+}
+
+// SeekGE moves the cursor to the first value greater than or equal to value.
+func (c *Cursor[T]) SeekGE(value T) bool {
+	c.path = c.path[:0]
+	found := 0
+	for node := c.tree.head; node != nil; {
+		c.path = append(c.path, node)
+		if c.tree.comparator(node.value, value) >= 0 {
+			found = len(c.path)
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+
+	c.path = c.path[:found]
+	return c.Valid()
+}
+
+// SeekLE moves the cursor to the last value less than or equal to value.
+func (c *Cursor[T]) SeekLE(value T) bool {
+	c.path = c.path[:0]
+	found := 0
+	for node := c.tree.head; node != nil; {
+		c.path = append(c.path, node)
+		if c.tree.comparator(node.value, value) <= 0 {
+			found = len(c.path)
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+
+	c.path = c.path[:found]
+	return c.Valid()
+}
+
+// Next moves the cursor to the next value in order. It returns false, leaving
+// the cursor invalid, when there is none.
+func (c *Cursor[T]) Next() bool {
+	if !c.Valid() {
+		return false
+	}
+
+	node := c.path[len(c.path)-1]
+	if node.right != nil {
+		c.pushLeft(node.right)
+		return true
+	}
+
+	for {
+		c.path = c.path[:len(c.path)-1]
+		if !c.Valid() || c.path[len(c.path)-1].left == node {
+			return c.Valid()
+		}
+		node = c.path[len(c.path)-1]
+	}
+}
+
+// Prev moves the cursor to the previous value in order. It returns false,
+// leaving the cursor invalid, when there is none.
+func (c *Cursor[T]) Prev() bool {
+	if !c.Valid() {
+		return false
+	}
+
+	node := c.path[len(c.path)-1]
+	if node.left != nil {
+		c.pushRight(node.left)
+		return true
+	}
+
+	for {
+		c.path = c.path[:len(c.path)-1]
+		if !c.Valid() || c.path[len(c.path)-1].right == node {
+			return c.Valid()
+		}
+		node = c.path[len(c.path)-1]
+	}
+}
+
+func (c *Cursor[T]) pushLeft(node *treeNode[T]) {
+	for ; node != nil; node = node.left {
+		c.path = append(c.path, node)
+	}
+}
+
+func (c *Cursor[T]) pushRight(node *treeNode[T]) {
+	for ; node != nil; node = node.right {
+		c.path = append(c.path, node)
+	}
+}
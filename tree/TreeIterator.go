@@ -0,0 +1,108 @@
+package tree
+
+import (
+	"go-utils/queue"
+	"iter"
+)
+
+// All yields the values in order.
+func (s *BinaryTree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		inOrder(s.head, yield)
+	}
+}
+
+// Backward yields the values in reverse order.
+func (s *BinaryTree[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		reverseOrder(s.head, yield)
+	}
+}
+
+func (s *BinaryTree[T]) Enumerate() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		index := 0
+		for value := range s.All() {
+			if !yield(index, value) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+func (s *BinaryTree[T]) PreOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		preOrder(s.head, yield)
+	}
+}
+
+func (s *BinaryTree[T]) PostOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		postOrder(s.head, yield)
+	}
+}
+
+func (s *BinaryTree[T]) LevelOrder() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if s.IsEmpty() {
+			return
+		}
+
+		nodes := queue.NewQueue[*treeNode[T]]()
+		nodes.Offer(s.head)
+		for !nodes.IsEmpty() {
+			node, _ := nodes.Poll()
+			if !yield(node.value) {
+				return
+			}
+
+			if node.left != nil {
+				nodes.Offer(node.left)
+			}
+			if node.right != nil {
+				nodes.Offer(node.right)
+			}
+		}
+	}
+}
+
+func Collect[T comparable](seq iter.Seq[T], comparator func(a, b T) int) *BinaryTree[T] {
+	result := NewBinaryTree[T](comparator)
+	for value := range seq {
+		result.Offer(value)
+	}
+	return result
+}
+
+func inOrder[T comparable](node *treeNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	return inOrder(node.left, yield) && yield(node.value) && inOrder(node.right, yield)
+}
+
+func reverseOrder[T comparable](node *treeNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	return reverseOrder(node.right, yield) && yield(node.value) && reverseOrder(node.left, yield)
+}
+
+func preOrder[T comparable](node *treeNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	return yield(node.value) && preOrder(node.left, yield) && preOrder(node.right, yield)
+}
+
+func postOrder[T comparable](node *treeNode[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	return postOrder(node.left, yield) && postOrder(node.right, yield) && yield(node.value)
+}
@@ -0,0 +1,420 @@
+package tree
+
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+var _ collection.Map[int, int] = (*TreeMap[int, int])(nil)
+
+type bound[K comparable] struct {
+	key       K
+	set       bool
+	inclusive bool
+}
+
+// TreeMap is a Map sorted by a comparator, backed by a red-black tree, so
+// Put, Get and Remove stay O(log n) even for keys inserted in sorted order.
+//
+// HeadMap, TailMap and SubMap return views that share the tree with the map
+// they were created from: changes through either are visible in both. Putting
+// a key outside the range of a view panics.
+type TreeMap[K comparable, V any] struct {
+	tree *rbTree[K, V]
+	lo   bound[K]
+	hi   bound[K]
+}
+
+func NewTreeMap[K comparable, V any](comparator func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: newRBTree[K, V](comparator)}
+}
+
+// Size is O(1) for a map and O(n) for a view.
+func (s *TreeMap[K, V]) Size() int {
+	if s.isUnbounded() {
+		return s.tree.size
+	}
+
+	size := 0
+	for node := s.firstNode(); node != nil; node = s.nextNode(node) {
+		size++
+	}
+	return size
+}
+
+func (s *TreeMap[K, V]) IsEmpty() bool {
+	return s.firstNode() == nil
+}
+
+func (s *TreeMap[K, V]) Clear() {
+	if s.isUnbounded() {
+		s.tree.clear()
+		return
+	}
+
+	for node := s.firstNode(); node != nil; {
+		next := s.nextNode(node)
+		s.tree.delete(node)
+		node = next
+	}
+}
+
+func (s *TreeMap[K, V]) Put(key K, value V) {
+	s.checkRange(key)
+	node, inserted := s.tree.insert(key, value)
+	if !inserted {
+		node.value = value
+	}
+}
+
+func (s *TreeMap[K, V]) PutAll(other collection.Map[K, V]) {
+	for key, value := range other.Entries() {
+		s.Put(key, value)
+	}
+}
+
+func (s *TreeMap[K, V]) Get(key K) (V, bool) {
+	node := s.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+func (s *TreeMap[K, V]) GetOrDefault(key K, defaultValue V) V {
+	if value, ok := s.Get(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+func (s *TreeMap[K, V]) ContainsKey(key K) bool {
+	return s.findNode(key) != nil
+}
+
+func (s *TreeMap[K, V]) Remove(key K) (V, bool) {
+	node := s.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+
+	s.tree.delete(node)
+	return node.value, true
+}
+
+// PutIfAbsent stores the value only if the key is missing. It returns the
+// value now associated with the key and whether the key was already present.
+func (s *TreeMap[K, V]) PutIfAbsent(key K, value V) (V, bool) {
+	s.checkRange(key)
+	node, inserted := s.tree.insert(key, value)
+	return node.value, !inserted
+}
+
+func (s *TreeMap[K, V]) ComputeIfAbsent(key K, mapping func(K) V) V {
+	s.checkRange(key)
+	if node := s.tree.find(key); node != nil {
+		return node.value
+	}
+
+	value := mapping(key)
+	s.tree.insert(key, value)
+	return value
+}
+
+// ComputeIfPresent replaces the value of an existing key with the result of
+// remapping, or removes the key if remapping returns false.
+func (s *TreeMap[K, V]) ComputeIfPresent(key K, remapping func(K, V) (V, bool)) (V, bool) {
+	node := s.findNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+
+	value, keep := remapping(key, node.value)
+	if !keep {
+		s.tree.delete(node)
+		var zero V
+		return zero, false
+	}
+
+	node.value = value
+	return value, true
+}
+
+// Merge stores value for a missing key, otherwise combines it with the current
+// value through remapping, removing the key if remapping returns false.
+func (s *TreeMap[K, V]) Merge(key K, value V, remapping func(V, V) (V, bool)) (V, bool) {
+	s.checkRange(key)
+	node, inserted := s.tree.insert(key, value)
+	if inserted {
+		return value, true
+	}
+
+	merged, keep := remapping(node.value, value)
+	if !keep {
+		s.tree.delete(node)
+		var zero V
+		return zero, false
+	}
+
+	node.value = merged
+	return merged, true
+}
+
+// First returns the entry with the smallest key.
+func (s *TreeMap[K, V]) First() (K, V, bool) {
+	return entryOf(s.firstNode())
+}
+
+// Last returns the entry with the largest key.
+func (s *TreeMap[K, V]) Last() (K, V, bool) {
+	return entryOf(s.lastNode())
+}
+
+// Floor returns the entry with the greatest key less than or equal to key.
+func (s *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return entryOf(s.floorNode(key, true))
+}
+
+// Lower returns the entry with the greatest key strictly less than key.
+func (s *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return entryOf(s.floorNode(key, false))
+}
+
+// Ceiling returns the entry with the least key greater than or equal to key.
+func (s *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return entryOf(s.ceilingNode(key, true))
+}
+
+// Higher returns the entry with the least key strictly greater than key.
+func (s *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return entryOf(s.ceilingNode(key, false))
+}
+
+// PollFirst removes and returns the entry with the smallest key.
+func (s *TreeMap[K, V]) PollFirst() (K, V, bool) {
+	node := s.firstNode()
+	if node != nil {
+		s.tree.delete(node)
+	}
+	return entryOf(node)
+}
+
+// PollLast removes and returns the entry with the largest key.
+func (s *TreeMap[K, V]) PollLast() (K, V, bool) {
+	node := s.lastNode()
+	if node != nil {
+		s.tree.delete(node)
+	}
+	return entryOf(node)
+}
+
+// HeadMap returns a view of the keys less than to, or equal to it when
+// inclusive is true.
+func (s *TreeMap[K, V]) HeadMap(to K, inclusive bool) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: s.tree, lo: s.lo, hi: s.tighterHi(bound[K]{key: to, set: true, inclusive: inclusive})}
+}
+
+// TailMap returns a view of the keys greater than from, or equal to it when
+// inclusive is true.
+func (s *TreeMap[K, V]) TailMap(from K, inclusive bool) *TreeMap[K, V] {
+	return &TreeMap[K, V]{tree: s.tree, lo: s.tighterLo(bound[K]{key: from, set: true, inclusive: inclusive}), hi: s.hi}
+}
+
+// SubMap returns a view of the keys between from and to.
+func (s *TreeMap[K, V]) SubMap(from K, fromInclusive bool, to K, toInclusive bool) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		tree: s.tree,
+		lo:   s.tighterLo(bound[K]{key: from, set: true, inclusive: fromInclusive}),
+		hi:   s.tighterHi(bound[K]{key: to, set: true, inclusive: toInclusive}),
+	}
+}
+
+func (s *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range s.Entries() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+func (s *TreeMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range s.Entries() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Entries yields the entries in ascending key order. The map may be modified
+// during iteration; iteration then continues after the last yielded key.
+func (s *TreeMap[K, V]) Entries() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := s.firstNode(); node != nil; {
+			modCount := s.tree.modCount
+			if !yield(node.key, node.value) {
+				return
+			}
+
+			if modCount == s.tree.modCount {
+				node = s.nextNode(node)
+			} else {
+				node = s.ceilingNode(node.key, false)
+			}
+		}
+	}
+}
+
+// Backward yields the entries in descending key order.
+func (s *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := s.lastNode(); node != nil; {
+			modCount := s.tree.modCount
+			if !yield(node.key, node.value) {
+				return
+			}
+
+			if modCount == s.tree.modCount {
+				node = s.prevNode(node)
+			} else {
+				node = s.floorNode(node.key, false)
+			}
+		}
+	}
+}
+
+func (s *TreeMap[K, V]) findNode(key K) *rbNode[K, V] {
+	if !s.inRange(key) {
+		return nil
+	}
+	return s.tree.find(key)
+}
+
+func (s *TreeMap[K, V]) firstNode() *rbNode[K, V] {
+	var node *rbNode[K, V]
+	if s.lo.set {
+		node = s.tree.ceiling(s.lo.key, s.lo.inclusive)
+	} else {
+		node = s.tree.first()
+	}
+	return s.withinHi(node)
+}
+
+func (s *TreeMap[K, V]) lastNode() *rbNode[K, V] {
+	var node *rbNode[K, V]
+	if s.hi.set {
+		node = s.tree.floor(s.hi.key, s.hi.inclusive)
+	} else {
+		node = s.tree.last()
+	}
+	return s.withinLo(node)
+}
+
+func (s *TreeMap[K, V]) nextNode(node *rbNode[K, V]) *rbNode[K, V] {
+	return s.withinHi(successor(node))
+}
+
+func (s *TreeMap[K, V]) prevNode(node *rbNode[K, V]) *rbNode[K, V] {
+	return s.withinLo(predecessor(node))
+}
+
+func (s *TreeMap[K, V]) floorNode(key K, inclusive bool) *rbNode[K, V] {
+	if s.tooHigh(key) {
+		return s.lastNode()
+	}
+	return s.withinLo(s.tree.floor(key, inclusive))
+}
+
+func (s *TreeMap[K, V]) ceilingNode(key K, inclusive bool) *rbNode[K, V] {
+	if s.tooLow(key) {
+		return s.firstNode()
+	}
+	return s.withinHi(s.tree.ceiling(key, inclusive))
+}
+
+func (s *TreeMap[K, V]) withinLo(node *rbNode[K, V]) *rbNode[K, V] {
+	if node == nil || s.tooLow(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *TreeMap[K, V]) withinHi(node *rbNode[K, V]) *rbNode[K, V] {
+	if node == nil || s.tooHigh(node.key) {
+		return nil
+	}
+	return node
+}
+
+func (s *TreeMap[K, V]) isUnbounded() bool {
+	return !s.lo.set && !s.hi.set
+}
+
+func (s *TreeMap[K, V]) inRange(key K) bool {
+	return !s.tooLow(key) && !s.tooHigh(key)
+}
+
+func (s *TreeMap[K, V]) tooLow(key K) bool {
+	if !s.lo.set {
+		return false
+	}
+
+	result := s.tree.comparator(key, s.lo.key)
+	return result < 0 || (result == 0 && !s.lo.inclusive)
+}
+
+func (s *TreeMap[K, V]) tooHigh(key K) bool {
+	if !s.hi.set {
+		return false
+	}
+
+	result := s.tree.comparator(key, s.hi.key)
+	return result > 0 || (result == 0 && !s.hi.inclusive)
+}
+
+func (s *TreeMap[K, V]) checkRange(key K) {
+	if !s.inRange(key) {
+		panic("tree: key out of view range")
+	}
+}
+
+// tighterLo keeps the more restrictive of the view's lower bound and lo, so a
+// view of a view never reaches outside its parent.
+func (s *TreeMap[K, V]) tighterLo(lo bound[K]) bound[K] {
+	if !s.lo.set {
+		return lo
+	}
+
+	result := s.tree.comparator(lo.key, s.lo.key)
+	if result > 0 || (result == 0 && !lo.inclusive) {
+		return lo
+	}
+	return s.lo
+}
+
+func (s *TreeMap[K, V]) tighterHi(hi bound[K]) bound[K] {
+	if !s.hi.set {
+		return hi
+	}
+
+	result := s.tree.comparator(hi.key, s.hi.key)
+	if result < 0 || (result == 0 && !hi.inclusive) {
+		return hi
+	}
+	return s.hi
+}
+
+func entryOf[K comparable, V any](node *rbNode[K, V]) (K, V, bool) {
+	if node == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return node.key, node.value, true
+}
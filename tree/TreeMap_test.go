@@ -0,0 +1,205 @@
+package tree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// checkRedBlack verifies the red-black invariants and returns the black height.
+func checkRedBlack[K comparable, V any](t *testing.T, tree *rbTree[K, V], node *rbNode[K, V]) int {
+	if node == nil {
+		return 1
+	}
+
+	if node.left != nil {
+		require.Same(t, node, node.left.parent, "TreeMap parent link is broken")
+		require.Less(t, tree.comparator(node.left.key, node.key), 0, "TreeMap order is broken")
+	}
+	if node.right != nil {
+		require.Same(t, node, node.right.parent, "TreeMap parent link is broken")
+		require.Greater(t, tree.comparator(node.right.key, node.key), 0, "TreeMap order is broken")
+	}
+	if node.red {
+		require.False(t, isRed(node.left) || isRed(node.right), "TreeMap red node has a red child")
+	}
+
+	left := checkRedBlack(t, tree, node.left)
+	right := checkRedBlack(t, tree, node.right)
+	require.Equal(t, left, right, "TreeMap black height is not equal")
+	if node.red {
+		return left
+	}
+	return left + 1
+}
+
+func TestTreeMap(t *testing.T) {
+	m := NewTreeMap[int, string](func(a, b int) int { return a - b })
+	for _, key := range []int{50, 20, 80, 10, 30, 70, 90} {
+		m.Put(key, "v")
+	}
+	m.Put(30, "thirty")
+
+	require.Equal(t, 7, m.Size(), "TreeMap size is not equal")
+	require.Equal(t, []int{10, 20, 30, 50, 70, 80, 90}, slices.Collect(m.Keys()), "TreeMap keys are not sorted")
+	value, ok := m.Get(30)
+	require.True(t, ok, "TreeMap get(30) is failed")
+	require.Equal(t, "thirty", value, "TreeMap get(30) is not matched")
+
+	key, _, ok := m.Floor(55)
+	require.True(t, ok, "TreeMap floor(55) is failed")
+	require.Equal(t, 50, key, "TreeMap floor(55) is not matched")
+	key, _, _ = m.Floor(50)
+	require.Equal(t, 50, key, "TreeMap floor(50) is not matched")
+	key, _, _ = m.Lower(50)
+	require.Equal(t, 30, key, "TreeMap lower(50) is not matched")
+	key, _, _ = m.Ceiling(55)
+	require.Equal(t, 70, key, "TreeMap ceiling(55) is not matched")
+	key, _, _ = m.Ceiling(70)
+	require.Equal(t, 70, key, "TreeMap ceiling(70) is not matched")
+	key, _, _ = m.Higher(70)
+	require.Equal(t, 80, key, "TreeMap higher(70) is not matched")
+	_, _, ok = m.Floor(5)
+	require.False(t, ok, "TreeMap floor(5) is not failed")
+	_, _, ok = m.Higher(90)
+	require.False(t, ok, "TreeMap higher(90) is not failed")
+
+	key, _, _ = m.First()
+	require.Equal(t, 10, key, "TreeMap first is not matched")
+	key, _, _ = m.Last()
+	require.Equal(t, 90, key, "TreeMap last is not matched")
+
+	key, _, ok = m.PollFirst()
+	require.True(t, ok, "TreeMap poll first is failed")
+	require.Equal(t, 10, key, "TreeMap poll first is not matched")
+	key, _, _ = m.PollLast()
+	require.Equal(t, 90, key, "TreeMap poll last is not matched")
+	require.Equal(t, []int{20, 30, 50, 70, 80}, slices.Collect(m.Keys()), "TreeMap keys are not equal")
+
+	backward := []int{}
+	for key := range m.Backward() {
+		backward = append(backward, key)
+	}
+	require.Equal(t, []int{80, 70, 50, 30, 20}, backward, "TreeMap backward is not matched")
+
+	m.Clear()
+	require.True(t, m.IsEmpty(), "TreeMap is not empty")
+	_, _, ok = m.PollFirst()
+	require.False(t, ok, "TreeMap poll first is not failed")
+}
+
+func TestTreeMap_Views(t *testing.T) {
+	m := NewTreeMap[int, int](func(a, b int) int { return a - b })
+	for i := 1; i <= 10; i++ {
+		m.Put(i, i*i)
+	}
+
+	head := m.HeadMap(4, false)
+	require.Equal(t, []int{1, 2, 3}, slices.Collect(head.Keys()), "TreeMap head map is not matched")
+	require.Equal(t, 4, m.HeadMap(4, true).Size(), "TreeMap head map size is not equal")
+
+	tail := m.TailMap(8, true)
+	require.Equal(t, []int{8, 9, 10}, slices.Collect(tail.Keys()), "TreeMap tail map is not matched")
+	require.Equal(t, []int{9, 10}, slices.Collect(m.TailMap(8, false).Keys()), "TreeMap tail map is not matched")
+
+	sub := m.SubMap(3, true, 7, false)
+	require.Equal(t, []int{3, 4, 5, 6}, slices.Collect(sub.Keys()), "TreeMap sub map is not matched")
+	require.Equal(t, 4, sub.Size(), "TreeMap sub map size is not equal")
+	require.False(t, sub.ContainsKey(7), "TreeMap sub map contains(7) is not matched")
+	_, ok := sub.Get(2)
+	require.False(t, ok, "TreeMap sub map get(2) is not matched")
+
+	key, _, _ := sub.First()
+	require.Equal(t, 3, key, "TreeMap sub map first is not matched")
+	key, _, _ = sub.Last()
+	require.Equal(t, 6, key, "TreeMap sub map last is not matched")
+	key, _, _ = sub.Floor(100)
+	require.Equal(t, 6, key, "TreeMap sub map floor(100) is not matched")
+	key, _, _ = sub.Ceiling(-100)
+	require.Equal(t, 3, key, "TreeMap sub map ceiling(-100) is not matched")
+	_, _, ok = sub.Higher(6)
+	require.False(t, ok, "TreeMap sub map higher(6) is not failed")
+	_, _, ok = sub.Lower(3)
+	require.False(t, ok, "TreeMap sub map lower(3) is not failed")
+
+	// views are backed by the map
+	m.Remove(4)
+	require.Equal(t, []int{3, 5, 6}, slices.Collect(sub.Keys()), "TreeMap sub map is not updated")
+	sub.Put(4, 0)
+	value, _ := m.Get(4)
+	require.Equal(t, 0, value, "TreeMap put through view is not matched")
+	require.Panics(t, func() { sub.Put(7, 0) }, "TreeMap put out of view range is not failed")
+
+	// nested views never widen the parent range
+	nested := sub.SubMap(0, true, 5, true)
+	require.Equal(t, []int{3, 4, 5}, slices.Collect(nested.Keys()), "TreeMap nested view is not matched")
+
+	key, _, _ = sub.PollFirst()
+	require.Equal(t, 3, key, "TreeMap sub map poll first is not matched")
+	sub.Clear()
+	require.True(t, sub.IsEmpty(), "TreeMap sub map is not empty")
+	require.Equal(t, []int{1, 2, 7, 8, 9, 10}, slices.Collect(m.Keys()), "TreeMap keys are not equal")
+}
+
+func TestTreeMap_RemoveWhileIterating(t *testing.T) {
+	m := NewTreeMap[int, int](func(a, b int) int { return a - b })
+	for i := 0; i < 20; i++ {
+		m.Put(i, i)
+	}
+
+	visited := []int{}
+	for key := range m.Keys() {
+		visited = append(visited, key)
+		m.Remove(key)
+		m.Remove(key + 1)
+	}
+	require.Equal(t, []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}, visited, "TreeMap iteration is not matched")
+	require.True(t, m.IsEmpty(), "TreeMap is not empty")
+}
+
+func TestTreeMap_SortedInsert(t *testing.T) {
+	m := NewTreeMap[int, int](func(a, b int) int { return a - b })
+	for i := 0; i < 100_000; i++ {
+		m.Put(i, i)
+	}
+
+	blackHeight := checkRedBlack(t, m.tree, m.tree.root)
+	require.LessOrEqual(t, blackHeight, 18, "TreeMap is not balanced")
+	require.Equal(t, 100_000, m.Size(), "TreeMap size is not equal")
+}
+
+func TestTreeMap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	m := NewTreeMap[int, int](func(a, b int) int { return a - b })
+	expected := map[int]int{}
+
+	for i := 0; i < 5000; i++ {
+		key := random.Intn(500)
+		if random.Intn(3) == 0 {
+			_, ok := m.Remove(key)
+			_, expectedOk := expected[key]
+			require.Equal(t, expectedOk, ok, "TreeMap remove is not matched")
+			delete(expected, key)
+		} else {
+			m.Put(key, i)
+			expected[key] = i
+		}
+
+		if i%100 == 0 {
+			checkRedBlack(t, m.tree, m.tree.root)
+		}
+	}
+
+	checkRedBlack(t, m.tree, m.tree.root)
+	keys := make([]int, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	require.Equal(t, keys, slices.Collect(m.Keys()), "TreeMap keys are not matched")
+	for key, value := range m.Entries() {
+		require.Equal(t, expected[key], value, "TreeMap value is not matched")
+	}
+}
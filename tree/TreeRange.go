@@ -0,0 +1,96 @@
+package tree
+
+import "iter"
+
+// Range yields, in order, the values between lo and hi. Only the subtrees that
+// can hold matching values are visited.
+func (s *BinaryTree[T]) Range(lo, hi T, inclusiveLo, inclusiveHi bool) iter.Seq[T] {
+	bounds := treeBounds[T]{lo, hi, inclusiveLo, inclusiveHi, s.comparator}
+	return func(yield func(T) bool) {
+		rangeOrder(s.head, bounds, yield)
+	}
+}
+
+// RemoveRange removes the values between lo and hi and returns how many were
+// removed.
+func (s *BinaryTree[T]) RemoveRange(lo, hi T, inclusiveLo, inclusiveHi bool) int {
+	bounds := treeBounds[T]{lo, hi, inclusiveLo, inclusiveHi, s.comparator}
+	size := sizeOf(s.head)
+	s.head = removeRange(s.head, bounds)
+	removed := size - sizeOf(s.head)
+	s.size -= removed
+	return removed
+}
+
+// DescendingValues returns the values from largest to smallest.
+func (s *BinaryTree[T]) DescendingValues() []T {
+	values := make([]T, 0, s.size)
+	for value := range s.Backward() {
+		values = append(values, value)
+	}
+	return values
+}
+
+type treeBounds[T comparable] struct {
+	lo, hi                   T
+	inclusiveLo, inclusiveHi bool
+	comparator               func(a, b T) int
+}
+
+func (b treeBounds[T]) tooLow(value T) bool {
+	result := b.comparator(value, b.lo)
+	return result < 0 || (result == 0 && !b.inclusiveLo)
+}
+
+func (b treeBounds[T]) tooHigh(value T) bool {
+	result := b.comparator(value, b.hi)
+	return result > 0 || (result == 0 && !b.inclusiveHi)
+}
+
+// rangeOrder relies on left <= node < right: the left subtree of a node that
+// is too low is too low as well, and so is the right subtree of one too high.
+func rangeOrder[T comparable](node *treeNode[T], bounds treeBounds[T], yield func(T) bool) bool {
+	if node == nil {
+		return true
+	}
+
+	low, high := bounds.tooLow(node.value), bounds.tooHigh(node.value)
+	if !low && !rangeOrder(node.left, bounds, yield) {
+		return false
+	}
+	if !low && !high && !yield(node.value) {
+		return false
+	}
+	if !high {
+		return rangeOrder(node.right, bounds, yield)
+	}
+	return true
+}
+
+func removeRange[T comparable](node *treeNode[T], bounds treeBounds[T]) *treeNode[T] {
+	if node == nil {
+		return nil
+	}
+
+	low, high := bounds.tooLow(node.value), bounds.tooHigh(node.value)
+	if !low {
+		node.left = removeRange(node.left, bounds)
+	}
+	if !high {
+		node.right = removeRange(node.right, bounds)
+	}
+
+	if !low && !high {
+		if node.right == nil {
+			return node.left
+		}
+
+		right, leftMost := detachLeftMostNode(node.right)
+		leftMost.left = node.left
+		leftMost.right = right
+		node = leftMost
+	}
+
+	node.size = sizeOf(node.left) + sizeOf(node.right) + 1
+	return node
+}
@@ -0,0 +1,138 @@
+package tree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryTree_Range(t *testing.T) {
+	tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+	tree.OfferAll([]int{50, 20, 80, 20, 70, 10, 90, 60, 30, 40})
+
+	require.Equal(t, []int{20, 20, 30, 40}, slices.Collect(tree.Range(20, 40, true, true)), "BinaryTree range [20, 40] is not matched")
+	require.Equal(t, []int{30}, slices.Collect(tree.Range(20, 40, false, false)), "BinaryTree range (20, 40) is not matched")
+	require.Equal(t, []int{10, 20, 20}, slices.Collect(tree.Range(0, 30, true, false)), "BinaryTree range [0, 30) is not matched")
+	require.Empty(t, slices.Collect(tree.Range(41, 49, true, true)), "BinaryTree range [41, 49] is not empty")
+
+	first := []int{}
+	for value := range tree.Range(0, 100, true, true) {
+		first = append(first, value)
+		if len(first) == 3 {
+			break
+		}
+	}
+	require.Equal(t, []int{10, 20, 20}, first, "BinaryTree range break is not matched")
+
+	require.Equal(t, []int{90, 80, 70, 60, 50, 40, 30, 20, 20, 10}, tree.DescendingValues(), "BinaryTree descending values are not matched")
+	require.Equal(t, []int{}, NewBinaryTree[int](tree.comparator).DescendingValues(), "BinaryTree descending values are not empty")
+
+	removed := tree.RemoveRange(20, 50, true, false)
+	require.Equal(t, 4, removed, "BinaryTree remove range count is not matched")
+	require.Equal(t, []int{10, 50, 60, 70, 80, 90}, tree.Values(), "BinaryTree values are not equal")
+	require.Equal(t, 6, tree.Size(), "BinaryTree size is not equal")
+	checkSizes(t, tree.head)
+
+	require.Equal(t, 0, tree.RemoveRange(20, 40, true, true), "BinaryTree remove range count is not matched")
+	require.Equal(t, 6, tree.RemoveRange(0, 100, true, true), "BinaryTree remove range count is not matched")
+	require.True(t, tree.IsEmpty(), "BinaryTree is not empty")
+}
+
+func TestBinaryTree_RemoveRangeRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+		expected := []int{}
+		for i := 0; i < 200; i++ {
+			value := random.Intn(100)
+			tree.Offer(value)
+			expected = append(expected, value)
+		}
+		slices.Sort(expected)
+
+		lo, hi := random.Intn(100), random.Intn(100)
+		inclusiveLo, inclusiveHi := random.Intn(2) == 0, random.Intn(2) == 0
+		kept := slices.DeleteFunc(slices.Clone(expected), func(v int) bool {
+			return (v > lo || (v == lo && inclusiveLo)) && (v < hi || (v == hi && inclusiveHi))
+		})
+
+		removed := tree.RemoveRange(lo, hi, inclusiveLo, inclusiveHi)
+		require.Equal(t, len(expected)-len(kept), removed, "BinaryTree remove range count is not matched")
+		require.Equal(t, kept, tree.Values(), "BinaryTree values are not equal")
+		require.Equal(t, len(kept), tree.Size(), "BinaryTree size is not equal")
+		checkSizes(t, tree.head)
+	}
+}
+
+func TestBinaryTree_Cursor(t *testing.T) {
+	tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+	cursor := tree.Cursor()
+	require.False(t, cursor.First(), "Cursor first on empty tree is not failed")
+	require.False(t, cursor.SeekGE(1), "Cursor seek on empty tree is not failed")
+	require.False(t, cursor.Next(), "Cursor next on invalid cursor is not failed")
+
+	tree.OfferAll([]int{50, 20, 80, 20, 70, 10, 90, 60, 30, 40})
+
+	forward := []int{}
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		forward = append(forward, cursor.Value())
+	}
+	require.Equal(t, tree.Values(), forward, "Cursor forward walk is not matched")
+	require.False(t, cursor.Valid(), "Cursor is valid after the end")
+
+	backward := []int{}
+	for ok := cursor.Last(); ok; ok = cursor.Prev() {
+		backward = append(backward, cursor.Value())
+	}
+	require.Equal(t, tree.DescendingValues(), backward, "Cursor backward walk is not matched")
+
+	require.True(t, cursor.SeekGE(20), "Cursor seek ge(20) is failed")
+	require.Equal(t, 20, cursor.Value(), "Cursor seek ge(20) is not matched")
+	cursor.Next()
+	require.Equal(t, 20, cursor.Value(), "Cursor next after seek ge(20) is not matched")
+	cursor.Next()
+	require.Equal(t, 30, cursor.Value(), "Cursor next is not matched")
+	cursor.Prev()
+	cursor.Prev()
+	require.Equal(t, 20, cursor.Value(), "Cursor prev is not matched")
+	require.True(t, cursor.Prev(), "Cursor prev is failed")
+	require.Equal(t, 10, cursor.Value(), "Cursor prev is not matched")
+	require.False(t, cursor.Prev(), "Cursor prev before the start is not failed")
+
+	require.True(t, cursor.SeekGE(55), "Cursor seek ge(55) is failed")
+	require.Equal(t, 60, cursor.Value(), "Cursor seek ge(55) is not matched")
+	require.False(t, cursor.SeekGE(91), "Cursor seek ge(91) is not failed")
+
+	require.True(t, cursor.SeekLE(55), "Cursor seek le(55) is failed")
+	require.Equal(t, 50, cursor.Value(), "Cursor seek le(55) is not matched")
+	require.True(t, cursor.SeekLE(20), "Cursor seek le(20) is failed")
+	require.Equal(t, 20, cursor.Value(), "Cursor seek le(20) is not matched")
+	cursor.Next()
+	require.Equal(t, 30, cursor.Value(), "Cursor next after seek le(20) is not matched")
+	require.False(t, cursor.SeekLE(5), "Cursor seek le(5) is not failed")
+	require.Equal(t, 0, cursor.Value(), "Cursor value on invalid cursor is not zero")
+}
+
+func TestBinaryTree_CursorPaging(t *testing.T) {
+	tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+	for _, value := range rand.New(rand.NewSource(1)).Perm(1000) {
+		tree.Offer(value)
+	}
+
+	// page through the tree, resuming after the last value of the previous page
+	pages := 0
+	next := 0
+	cursor := tree.Cursor()
+	for ok := cursor.SeekGE(next); ok; ok = cursor.SeekGE(next) {
+		for i := 0; i < 100 && cursor.Valid(); i++ {
+			require.Equal(t, next, cursor.Value(), "Cursor page value is not matched")
+			next++
+			cursor.Next()
+		}
+		pages++
+	}
+	require.Equal(t, 10, pages, "Cursor page count is not matched")
+	require.Equal(t, 1000, next, "Cursor paged values are not matched")
+}
@@ -0,0 +1,161 @@
+package tree
+
+import (
+	"go-utils/collection"
+	"iter"
+)
+
+var _ collection.SortedCollection[int] = (*TreeSet[int])(nil)
+
+// TreeSet is a sorted set of distinct values backed by a TreeMap. Unlike
+// BinaryTree it stays balanced, and offering a value already present is a
+// no-op.
+type TreeSet[T comparable] struct {
+	items *TreeMap[T, struct{}]
+}
+
+func NewTreeSet[T comparable](comparator func(a, b T) int) *TreeSet[T] {
+	return &TreeSet[T]{items: NewTreeMap[T, struct{}](comparator)}
+}
+
+func (s *TreeSet[T]) Size() int {
+	return s.items.Size()
+}
+
+func (s *TreeSet[T]) IsEmpty() bool {
+	return s.items.IsEmpty()
+}
+
+func (s *TreeSet[T]) Values() []T {
+	values := []T{}
+	for value := range s.items.Keys() {
+		values = append(values, value)
+	}
+	return values
+}
+
+func (s *TreeSet[T]) Clear() {
+	s.items.Clear()
+}
+
+func (s *TreeSet[T]) Contains(value T) bool {
+	return s.items.ContainsKey(value)
+}
+
+func (s *TreeSet[T]) Offer(value T) {
+	s.items.Put(value, struct{}{})
+}
+
+func (s *TreeSet[T]) OfferAll(values []T) {
+	for _, value := range values {
+		s.Offer(value)
+	}
+}
+
+func (s *TreeSet[T]) Remove(value T) bool {
+	_, ok := s.items.Remove(value)
+	return ok
+}
+
+func (s *TreeSet[T]) Peek() (T, error) {
+	value, ok := s.First()
+	if !ok {
+		return value, collection.ErrEmpty
+	}
+	return value, nil
+}
+
+func (s *TreeSet[T]) Poll() (T, error) {
+	value, ok := s.PollFirst()
+	if !ok {
+		return value, collection.ErrEmpty
+	}
+	return value, nil
+}
+
+// MustPeek is like Peek but panics on error.
+func (s *TreeSet[T]) MustPeek() T {
+	return collection.Must(s.Peek())
+}
+
+// MustPoll is like Poll but panics on error.
+func (s *TreeSet[T]) MustPoll() T {
+	return collection.Must(s.Poll())
+}
+
+func (s *TreeSet[T]) First() (T, bool) {
+	value, _, ok := s.items.First()
+	return value, ok
+}
+
+func (s *TreeSet[T]) Last() (T, bool) {
+	value, _, ok := s.items.Last()
+	return value, ok
+}
+
+// Floor returns the greatest value less than or equal to value.
+func (s *TreeSet[T]) Floor(value T) (T, bool) {
+	floor, _, ok := s.items.Floor(value)
+	return floor, ok
+}
+
+// Lower returns the greatest value strictly less than value.
+func (s *TreeSet[T]) Lower(value T) (T, bool) {
+	lower, _, ok := s.items.Lower(value)
+	return lower, ok
+}
+
+// Ceiling returns the least value greater than or equal to value.
+func (s *TreeSet[T]) Ceiling(value T) (T, bool) {
+	ceiling, _, ok := s.items.Ceiling(value)
+	return ceiling, ok
+}
+
+// Higher returns the least value strictly greater than value.
+func (s *TreeSet[T]) Higher(value T) (T, bool) {
+	higher, _, ok := s.items.Higher(value)
+	return higher, ok
+}
+
+func (s *TreeSet[T]) PollFirst() (T, bool) {
+	value, _, ok := s.items.PollFirst()
+	return value, ok
+}
+
+func (s *TreeSet[T]) PollLast() (T, bool) {
+	value, _, ok := s.items.PollLast()
+	return value, ok
+}
+
+// HeadSet returns a view of the values less than to, or equal to it when
+// inclusive is true. See TreeMap.HeadMap.
+func (s *TreeSet[T]) HeadSet(to T, inclusive bool) *TreeSet[T] {
+	return &TreeSet[T]{items: s.items.HeadMap(to, inclusive)}
+}
+
+// TailSet returns a view of the values greater than from, or equal to it when
+// inclusive is true. See TreeMap.TailMap.
+func (s *TreeSet[T]) TailSet(from T, inclusive bool) *TreeSet[T] {
+	return &TreeSet[T]{items: s.items.TailMap(from, inclusive)}
+}
+
+// SubSet returns a view of the values between from and to. See TreeMap.SubMap.
+func (s *TreeSet[T]) SubSet(from T, fromInclusive bool, to T, toInclusive bool) *TreeSet[T] {
+	return &TreeSet[T]{items: s.items.SubMap(from, fromInclusive, to, toInclusive)}
+}
+
+// All yields the values in ascending order.
+func (s *TreeSet[T]) All() iter.Seq[T] {
+	return s.items.Keys()
+}
+
+// Backward yields the values in descending order.
+func (s *TreeSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range s.items.Backward() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package tree
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeSet(t *testing.T) {
+	set := NewTreeSet[int](func(a, b int) int { return a - b })
+	set.OfferAll([]int{5, 1, 9, 3, 7})
+	set.Offer(5)
+
+	require.Equal(t, 5, set.Size(), "TreeSet size is not equal")
+	require.Equal(t, []int{1, 3, 5, 7, 9}, set.Values(), "TreeSet values are not equal")
+	require.True(t, set.Contains(7), "TreeSet contains(7) is not matched")
+
+	value, _ := set.Floor(6)
+	require.Equal(t, 5, value, "TreeSet floor(6) is not matched")
+	value, _ = set.Lower(5)
+	require.Equal(t, 3, value, "TreeSet lower(5) is not matched")
+	value, _ = set.Ceiling(6)
+	require.Equal(t, 7, value, "TreeSet ceiling(6) is not matched")
+	value, _ = set.Higher(7)
+	require.Equal(t, 9, value, "TreeSet higher(7) is not matched")
+	_, ok := set.Higher(9)
+	require.False(t, ok, "TreeSet higher(9) is not failed")
+
+	require.Equal(t, []int{1, 3}, set.HeadSet(5, false).Values(), "TreeSet head set is not matched")
+	require.Equal(t, []int{5, 7, 9}, set.TailSet(5, true).Values(), "TreeSet tail set is not matched")
+	require.Equal(t, []int{3, 5, 7}, set.SubSet(3, true, 7, true).Values(), "TreeSet sub set is not matched")
+	require.Equal(t, []int{9, 7, 5, 3, 1}, slices.Collect(set.Backward()), "TreeSet backward is not matched")
+
+	value, err := set.Peek()
+	require.Nil(t, err, "TreeSet peek is failed")
+	require.Equal(t, 1, value, "TreeSet peek is not matched")
+	value, _ = set.PollFirst()
+	require.Equal(t, 1, value, "TreeSet poll first is not matched")
+	value, _ = set.PollLast()
+	require.Equal(t, 9, value, "TreeSet poll last is not matched")
+	require.Equal(t, []int{3, 5, 7}, slices.Collect(set.All()), "TreeSet values are not equal")
+
+	require.True(t, set.Remove(5), "TreeSet remove(5) is not matched")
+	require.False(t, set.Remove(5), "TreeSet remove(5) is not matched")
+
+	set.Clear()
+	_, err = set.Poll()
+	require.NotNil(t, err, "TreeSet poll is not failed")
+}
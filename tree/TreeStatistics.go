@@ -0,0 +1,92 @@
+package tree
+
+import (
+	"fmt"
+	"go-utils/collection"
+	"math"
+)
+
+// The order-statistic operations walk a single root-to-leaf path using the
+// subtree sizes kept in every node, so they run in O(height) without copying
+// the tree.
+
+// Select returns the k-th smallest value, counting from 0.
+func (s *BinaryTree[T]) Select(k int) (T, error) {
+	if k < 0 || k >= s.size {
+		var zero T
+		return zero, &collection.IndexError{Index: k, Size: s.size}
+	}
+
+	node := s.head
+	for {
+		left := sizeOf(node.left)
+		if k < left {
+			node = node.left
+		} else if k == left {
+			return node.value, nil
+		} else {
+			k -= left + 1
+			node = node.right
+		}
+	}
+}
+
+// Rank returns the number of values strictly less than value.
+func (s *BinaryTree[T]) Rank(value T) int {
+	rank := 0
+	for node := s.head; node != nil; {
+		if s.comparator(value, node.value) <= 0 {
+			node = node.left
+		} else {
+			rank += sizeOf(node.left) + 1
+			node = node.right
+		}
+	}
+	return rank
+}
+
+// CountRange returns the number of values v with lo <= v <= hi.
+func (s *BinaryTree[T]) CountRange(lo, hi T) int {
+	return max(s.countLessOrEqual(hi)-s.Rank(lo), 0)
+}
+
+// Percentile returns the value at percentile p (0 to 100) using the
+// nearest-rank method, so the result is always a value stored in the tree. A
+// p outside that range gives an error wrapping collection.ErrIndexOutOfRange.
+func (s *BinaryTree[T]) Percentile(p float64) (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+	if math.IsNaN(p) || p < 0 || p > 100 {
+		var zero T
+		return zero, fmt.Errorf("percentile %v is out of range [0, 100]: %w", p, collection.ErrIndexOutOfRange)
+	}
+
+	rank := int(math.Ceil(p / 100 * float64(s.size)))
+	return s.Select(max(rank-1, 0))
+}
+
+// Median returns the middle value, or the lower of the two middle values when
+// the size is even.
+func (s *BinaryTree[T]) Median() (T, error) {
+	if s.IsEmpty() {
+		var zero T
+		return zero, collection.ErrEmpty
+	}
+
+	return s.Select((s.size - 1) / 2)
+}
+
+func (s *BinaryTree[T]) countLessOrEqual(value T) int {
+	count := 0
+	for node := s.head; node != nil; {
+		if s.comparator(value, node.value) < 0 {
+			node = node.left
+		} else {
+			count += sizeOf(node.left) + 1
+			node = node.right
+		}
+	}
+	return count
+}
@@ -0,0 +1,112 @@
+package tree
+
+import (
+	"go-utils/collection"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// checkSizes verifies that every node's size matches its subtree.
+func checkSizes[T comparable](t *testing.T, node *treeNode[T]) int {
+	if node == nil {
+		return 0
+	}
+
+	size := checkSizes(t, node.left) + checkSizes(t, node.right) + 1
+	require.Equal(t, size, node.size, "BinaryTree subtree size is not equal")
+	return size
+}
+
+func TestBinaryTree_OrderStatistics(t *testing.T) {
+	tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+	_, err := tree.Select(0)
+	require.NotNil(t, err, "BinaryTree select(0) is not failed")
+	_, err = tree.Median()
+	require.NotNil(t, err, "BinaryTree median is not failed")
+	_, err = tree.Percentile(50)
+	require.NotNil(t, err, "BinaryTree percentile is not failed")
+
+	tree.OfferAll([]int{50, 20, 80, 20, 70, 10, 90, 60, 30, 40})
+	// sorted: 10 20 20 30 40 50 60 70 80 90
+
+	value, err := tree.Select(0)
+	require.Nil(t, err, "BinaryTree select(0) is failed")
+	require.Equal(t, 10, value, "BinaryTree select(0) is not matched")
+	value, _ = tree.Select(2)
+	require.Equal(t, 20, value, "BinaryTree select(2) is not matched")
+	value, _ = tree.Select(9)
+	require.Equal(t, 90, value, "BinaryTree select(9) is not matched")
+	_, err = tree.Select(10)
+	require.NotNil(t, err, "BinaryTree select(10) is not failed")
+	_, err = tree.Select(-1)
+	require.NotNil(t, err, "BinaryTree select(-1) is not failed")
+
+	require.Equal(t, 0, tree.Rank(10), "BinaryTree rank(10) is not matched")
+	require.Equal(t, 1, tree.Rank(20), "BinaryTree rank(20) is not matched")
+	require.Equal(t, 3, tree.Rank(25), "BinaryTree rank(25) is not matched")
+	require.Equal(t, 10, tree.Rank(100), "BinaryTree rank(100) is not matched")
+
+	require.Equal(t, 4, tree.CountRange(20, 40), "BinaryTree count range(20, 40) is not matched")
+	require.Equal(t, 10, tree.CountRange(0, 100), "BinaryTree count range(0, 100) is not matched")
+	require.Equal(t, 0, tree.CountRange(41, 49), "BinaryTree count range(41, 49) is not matched")
+	require.Equal(t, 0, tree.CountRange(90, 10), "BinaryTree count range(90, 10) is not matched")
+
+	value, _ = tree.Median()
+	require.Equal(t, 40, value, "BinaryTree median is not matched")
+	value, _ = tree.Percentile(0)
+	require.Equal(t, 10, value, "BinaryTree percentile(0) is not matched")
+	value, _ = tree.Percentile(90)
+	require.Equal(t, 80, value, "BinaryTree percentile(90) is not matched")
+	value, _ = tree.Percentile(100)
+	require.Equal(t, 90, value, "BinaryTree percentile(100) is not matched")
+	_, err = tree.Percentile(101)
+	require.ErrorIs(t, err, collection.ErrIndexOutOfRange, "BinaryTree percentile(101) is not failed")
+}
+
+func TestBinaryTree_OrderStatisticsConsistency(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	tree := NewBinaryTree[int](func(a, b int) int { return a - b })
+	expected := []int{}
+
+	for i := 0; i < 3000; i++ {
+		switch random.Intn(4) {
+		case 0:
+			value := random.Intn(200)
+			removed := tree.Remove(value)
+			index := slices.Index(expected, value)
+			require.Equal(t, index >= 0, removed, "BinaryTree remove is not matched")
+			if removed {
+				expected = slices.Delete(expected, index, index+1)
+			}
+		case 1:
+			if value, err := tree.Poll(); err == nil {
+				require.Equal(t, expected[0], value, "BinaryTree poll is not matched")
+				expected = expected[1:]
+			}
+		default:
+			value := random.Intn(200)
+			tree.Offer(value)
+			index, _ := slices.BinarySearch(expected, value)
+			expected = slices.Insert(expected, index, value)
+		}
+
+		if i%100 == 0 {
+			tree = tree.Clone()
+			checkSizes(t, tree.head)
+		}
+	}
+
+	checkSizes(t, tree.head)
+	for k, want := range expected {
+		value, err := tree.Select(k)
+		require.Nil(t, err, "BinaryTree select is failed")
+		require.Equal(t, want, value, "BinaryTree select(%d) is not matched", k)
+	}
+	for value := -1; value <= 200; value++ {
+		rank, _ := slices.BinarySearch(expected, value)
+		require.Equal(t, rank, tree.Rank(value), "BinaryTree rank(%d) is not matched", value)
+	}
+}